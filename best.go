@@ -44,6 +44,19 @@ func SetConfig(cfg *Config) {
 	globalConfig = cfg
 }
 
+// UseConfigAliases enables alias matching with the config's aliases added to the defaults
+// Agent constructors never enable aliases; call this once before the assertions that rely on them.
+// Alias matching stays off when the config has no aliases section.
+func UseConfigAliases(cfg *Config) {
+	if cfg.Aliases == nil {
+		return
+	}
+	for alias, ids := range cfg.Aliases {
+		SetAlias(alias, ids...)
+	}
+	EnableAliases(true)
+}
+
 // NewDefaultAgent creates a new agent using the global configuration
 // The configuration is automatically loaded from best.config.yml
 func NewDefaultAgent() *Agent {
@@ -59,39 +72,71 @@ func NewDefaultAgent() *Agent {
 //	agent := best.CreateAgent("TestBot")  // Use config file settings
 //	agent := best.CreateAgent("Player1", best.WithHost("example.com"))  // Override host
 func CreateAgent(username string, options ...AgentOption) *Agent {
-	cfg := GetConfig()
+	// Start with configuration from file; the username and user-provided options override it
+	agentOptions := append(configOptions(GetConfig()), WithUsername(username))
+	agentOptions = append(agentOptions, options...)
 
-	// Start with configuration from file
-	agentOptions := []AgentOption{
+	return NewAgent(agentOptions...)
+}
+
+// configOptions converts a configuration into agent options
+// It is the single path from config keys to options shared by CreateAgent, NewAgentFromConfig
+// and NewDefaultAgent; unset keys leave the agent defaults in place.
+func configOptions(cfg *Config) []AgentOption {
+	options := []AgentOption{
 		WithHost(cfg.Server.Host),
 		WithPort(uint16(cfg.Server.Port)),
-		WithUsername(username), // Override username with provided value
+		WithUsername(cfg.Agent.Username),
 	}
 
-	// Add version if specified in config
 	if cfg.Server.Version != "" {
-		agentOptions = append(agentOptions, WithVersion(cfg.Server.Version))
+		options = append(options, WithVersion(cfg.Server.Version))
 	}
 
-	// Add timeout if specified in config
 	if cfg.Agent.Timeout > 0 {
-		agentOptions = append(agentOptions, WithTimeout(time.Duration(cfg.Agent.Timeout)*time.Second))
+		options = append(options, WithTimeout(time.Duration(cfg.Agent.Timeout)*time.Second))
+	}
+
+	if cfg.Agent.SpawnTimeout > 0 {
+		options = append(options, WithSpawnTimeout(time.Duration(cfg.Agent.SpawnTimeout)*time.Second))
+	}
+
+	// Wait for the first attribute sync
+	if cfg.Agent.WaitForAttributes > 0 {
+		options = append(options, WithWaitForAttributes(time.Duration(cfg.Agent.WaitForAttributes)*time.Second))
+	}
+
+	if len(cfg.Macros) > 0 {
+		options = append(options, WithMacros(cfg.Macros))
 	}
 
-	// Add command prefix if specified in config
 	if cfg.Agent.CommandPrefix != "" {
-		agentOptions = append(agentOptions, WithCommandPrefix(cfg.Agent.CommandPrefix))
+		options = append(options, WithCommandPrefix(cfg.Agent.CommandPrefix))
 	}
 
-	// Add command send method if specified in config
 	if cfg.Agent.CommandSendMethod != "" {
-		agentOptions = append(agentOptions, WithCommandSendMethod(cfg.Agent.CommandSendMethod))
+		options = append(options, WithCommandSendMethod(cfg.Agent.CommandSendMethod))
 	}
 
-	// Append user-provided options (these will override config file settings)
-	agentOptions = append(agentOptions, options...)
+	// Cap command output lines
+	if cfg.Agent.MaxOutputLines > 0 {
+		options = append(options, WithMaxCommandOutputLines(cfg.Agent.MaxOutputLines))
+	}
 
-	return NewAgent(agentOptions...)
+	if cfg.Agent.EventBufferSize > 0 {
+		options = append(options, WithEventBufferSize(cfg.Agent.EventBufferSize))
+	}
+
+	if cfg.Agent.MovementMode != "" {
+		options = append(options, WithMovementMode(MovementMode(cfg.Agent.MovementMode)))
+	}
+
+	// Accept resource packs unless the config declines them
+	if cfg.Agent.ResourcePacks != "" {
+		options = append(options, WithResourcePacks(cfg.Agent.ResourcePacks != "decline"))
+	}
+
+	return options
 }
 
 // Re-export main types and functions for convenience
@@ -106,10 +151,60 @@ var (
 	WithPort              = agent.WithPort
 	WithUsername          = agent.WithUsername
 	WithTimeout           = agent.WithTimeout
+	WithSpawnTimeout      = agent.WithSpawnTimeout
+	WithWaitForAttributes = agent.WithWaitForAttributes
+	WithResourcePacks     = agent.WithResourcePacks
 	WithVersion           = agent.WithVersion
 	WithXUID              = agent.WithXUID
+	WithIdentityRotation  = agent.WithIdentityRotation
+	WithKeepAlive         = agent.WithKeepAlive
+	WithMaxEntities       = agent.WithMaxEntities
+	WithEventBufferSize   = agent.WithEventBufferSize
+	WithHistorySize       = agent.WithHistorySize
+	WithSessionRecorder   = agent.WithSessionRecorder
 	WithCommandPrefix     = agent.WithCommandPrefix
 	WithCommandSendMethod = agent.WithCommandSendMethod
+	WithMovementMode      = agent.WithMovementMode
+	WithMacros            = agent.WithMacros
+
+	WithMaxCommandOutputLines   = agent.WithMaxCommandOutputLines
+	WithIdentityRotationBackoff = agent.WithIdentityRotationBackoff
+	WithIdentityRotationRename  = agent.WithIdentityRotationRename
+)
+
+// Movement modes
+type MovementMode = agent.MovementMode
+
+const (
+	MovementModeMovePlayer = agent.MovementModeMovePlayer
+	MovementModeAuthInput  = agent.MovementModeAuthInput
+)
+
+// Reconnection
+type ReconnectOptions = agent.ReconnectOptions
+
+// Command building
+type CommandBuilder = agent.CommandBuilder
+
+var (
+	NewCommand = agent.NewCommand
+	QuoteArg   = agent.QuoteArg
+)
+
+// Custom form responses
+var (
+	CustomFormResponse = agent.CustomFormResponse
+	CustomFormDefaults = agent.CustomFormDefaults
+
+	CustomFormResponseByLabel = agent.CustomFormResponseByLabel
+)
+
+// Agent errors, to be checked with errors.Is
+var (
+	ErrNotConnected        = agent.ErrNotConnected
+	ErrAlreadyConnected    = agent.ErrAlreadyConnected
+	ErrCommandTimeout      = agent.ErrCommandTimeout
+	ErrUnknownMovementMode = agent.ErrUnknownMovementMode
 )
 
 // Event types
@@ -132,6 +227,8 @@ const (
 	EventBlockUpdate         = events.EventBlockUpdate
 	EventInventoryUpdate     = events.EventInventoryUpdate
 	EventInventorySlotUpdate = events.EventInventorySlotUpdate
+	EventInventoryChanged    = events.EventInventoryChanged
+	EventHeldSlotUpdate      = events.EventHeldSlotUpdate
 	EventEffectAdd           = events.EventEffectAdd
 	EventEffectRemove        = events.EventEffectRemove
 	EventEffectUpdate        = events.EventEffectUpdate
@@ -140,6 +237,9 @@ const (
 
 	// Phase 3 events (Player state)
 	EventHungerUpdate     = events.EventHungerUpdate
+	EventExperienceUpdate = events.EventExperienceUpdate
+	EventTimeUpdate       = events.EventTimeUpdate
+	EventWeatherUpdate    = events.EventWeatherUpdate
 	EventGamemodeUpdate   = events.EventGamemodeUpdate
 	EventPermissionUpdate = events.EventPermissionUpdate
 	EventTagUpdate        = events.EventTagUpdate
@@ -147,12 +247,21 @@ const (
 	// UI/Display events
 	EventTitle       = events.EventTitle
 	EventScoreUpdate = events.EventScoreUpdate
+	EventSound       = events.EventSound
+	EventParticle    = events.EventParticle
+	EventBossBar     = events.EventBossBar
+
+	// Player list events
+	EventPlayerJoin       = events.EventPlayerJoin
+	EventPlayerLeave      = events.EventPlayerLeave
+	EventPlayerListUpdate = events.EventPlayerListUpdate
 )
 
 // Common types
 type Position = types.Position
 type Rotation = types.Rotation
 type PlayerState = types.PlayerState
+type Experience = types.Experience
 type CommandOutput = types.CommandOutput
 type ChatMessage = types.ChatMessage
 type ClientOptions = types.ClientOptions
@@ -160,14 +269,30 @@ type ClientOptions = types.ClientOptions
 // Phase 2 types
 type Block = types.Block
 type BlockUpdate = types.BlockUpdate
+type BlockFace = types.BlockFace
 type InventoryItem = types.InventoryItem
+type InventoryChange = types.InventoryChange
 type Effect = types.Effect
 type Entity = types.Entity
+type Death = types.Death
 type World = world.World
 type BlockRegistry = world.BlockRegistry
 
+// Block faces
+const (
+	BlockFaceDown  = types.BlockFaceDown
+	BlockFaceUp    = types.BlockFaceUp
+	BlockFaceNorth = types.BlockFaceNorth
+	BlockFaceSouth = types.BlockFaceSouth
+	BlockFaceWest  = types.BlockFaceWest
+	BlockFaceEast  = types.BlockFaceEast
+)
+
 // UI/Display types
 type TitleDisplay = types.TitleDisplay
+type SoundPlay = types.SoundPlay
+type ParticleSpawn = types.ParticleSpawn
+type BossBar = types.BossBar
 type ScoreboardEntry = types.ScoreboardEntry
 
 // Phase 3: Assertion types
@@ -177,6 +302,17 @@ type PositionAssertion = assertions.PositionAssertion
 type ChatAssertion = assertions.ChatAssertion
 type CommandOutputAssertion = assertions.CommandOutputAssertion
 type InventoryAssertion = assertions.InventoryAssertion
+type ConnectionAssertion = assertions.ConnectionAssertion
+type DeathAssertion = assertions.DeathAssertion
+type HeldItemAssertion = assertions.HeldItemAssertion
+type SoundAssertion = assertions.SoundAssertion
+type ParticleAssertion = assertions.ParticleAssertion
+type BossBarAssertion = assertions.BossBarAssertion
+type PacketAssertion = assertions.PacketAssertion
+type ExperienceAssertion = assertions.ExperienceAssertion
+type WeatherAssertion = assertions.WeatherAssertion
+type TimeAssertion = assertions.TimeAssertion
+type ItemMatcher = assertions.ItemMatcher
 type ChatOptions = assertions.ChatOptions
 type CommandOutputOptions = assertions.CommandOutputOptions
 
@@ -187,6 +323,10 @@ type EffectAssertion = assertions.EffectAssertion
 type GamemodeAssertion = assertions.GamemodeAssertion
 type PermissionAssertion = assertions.PermissionAssertion
 type TagAssertion = assertions.TagAssertion
+type PlayerListAssertion = assertions.PlayerListAssertion
+
+// World assertion types
+type BlockAssertion = assertions.BlockAssertion
 
 // UI/Display assertion types
 type TitleAssertion = assertions.TitleAssertion
@@ -195,6 +335,23 @@ type ScoreboardAssertion = assertions.ScoreboardAssertion
 var (
 	NewAssertionContext = assertions.NewAssertionContext
 	NewAssertionError   = assertions.NewAssertionError
+
+	// Item ID matching for inventory assertions
+	FuzzyItemMatcher = assertions.FuzzyItemMatcher
+	ExactItemMatcher = assertions.ExactItemMatcher
+	NormalizeItemID  = assertions.NormalizeItemID
+
+	// Opt-in item/block aliases for item and block assertions
+	EnableAliases  = assertions.EnableAliases
+	AliasesEnabled = assertions.AliasesEnabled
+	SetAlias       = assertions.SetAlias
+	RemoveAlias    = assertions.RemoveAlias
+	ResetAliases   = assertions.ResetAliases
+	ResolveAlias   = assertions.ResolveAlias
+
+	// Retrying state assertions until the first sync after spawning
+	SetStateRetry = assertions.SetStateRetry
+	StateRetry    = assertions.StateRetry
 )
 
 // Phase 4: Test Runner types
@@ -211,10 +368,14 @@ type TestResult = runner.TestResult
 type TestRunnerOptions = runner.TestRunnerOptions
 type Reporter = runner.Reporter
 type ServerInfo = runner.ServerInfo
+type JSONReporter = runner.JSONReporter
+type JSONReport = runner.JSONReport
 
 var (
-	NewTestRunner      = runner.NewTestRunner
-	NewConsoleReporter = runner.NewConsoleReporter
+	NewTestRunner       = runner.NewTestRunner
+	NewConsoleReporter  = runner.NewConsoleReporter
+	NewJSONReporter     = runner.NewJSONReporter
+	NewJSONFileReporter = runner.NewJSONFileReporter
 )
 
 // Config types
@@ -234,25 +395,7 @@ var (
 
 // NewAgentFromConfig creates a new agent from a config file
 func NewAgentFromConfig(cfg *Config) *Agent {
-	options := []AgentOption{
-		WithHost(cfg.Server.Host),
-		WithPort(uint16(cfg.Server.Port)),
-		WithUsername(cfg.Agent.Username),
-	}
-
-	if cfg.Server.Version != "" {
-		options = append(options, WithVersion(cfg.Server.Version))
-	}
-
-	if cfg.Agent.Timeout > 0 {
-		options = append(options, WithTimeout(time.Duration(cfg.Agent.Timeout)*time.Second))
-	}
-
-	if cfg.Agent.CommandPrefix != "" {
-		options = append(options, WithCommandPrefix(cfg.Agent.CommandPrefix))
-	}
-
-	return NewAgent(options...)
+	return NewAgent(configOptions(cfg)...)
 }
 
 // Scenario types
@@ -265,13 +408,17 @@ type ScenarioOption = scenario.Option
 type ScenarioReporter = scenario.Reporter
 
 const (
-	ScenarioStepPending  = scenario.StepStatusPending
-	ScenarioStepRunning  = scenario.StepStatusRunning
-	ScenarioStepPassed   = scenario.StepStatusPassed
-	ScenarioStepFailed   = scenario.StepStatusFailed
-	ScenarioStepSkipped  = scenario.StepStatusSkipped
+	ScenarioStepPending   = scenario.StepStatusPending
+	ScenarioStepRunning   = scenario.StepStatusRunning
+	ScenarioStepPassed    = scenario.StepStatusPassed
+	ScenarioStepFailed    = scenario.StepStatusFailed
+	ScenarioStepSkipped   = scenario.StepStatusSkipped
+	ScenarioStepCancelled = scenario.StepStatusCancelled
 )
 
+// ErrScenarioStepCancelled is wrapped by the error of a step interrupted by cancelling the run
+var ErrScenarioStepCancelled = scenario.ErrStepCancelled
+
 var (
 	// Scenario runner functions
 	NewScenarioRunner            = scenario.NewRunner
@@ -279,13 +426,21 @@ var (
 	RunScenarioFromFile          = scenario.RunFromFile
 	RunScenarioFromStringWithCfg = scenario.RunFromStringWithConfig
 	RunScenarioFromFileWithCfg   = scenario.RunFromFileWithConfig
+	NewStructuredScenarioRunner  = scenario.NewStructuredRunner
+	RunStructuredScenarioFile    = scenario.RunFromStructuredFile
+	LoadStructuredScenario       = scenario.LoadStructuredFile
+	IsStructuredScenarioFile     = scenario.IsStructuredFile
 
 	// Scenario options
-	ScenarioWithTimeout     = scenario.WithTimeout
-	ScenarioWithStepTimeout = scenario.WithStepTimeout
-	ScenarioWithVerbose     = scenario.WithVerbose
-	ScenarioWithOnStepStart = scenario.WithOnStepStart
-	ScenarioWithOnStepEnd   = scenario.WithOnStepEnd
+	ScenarioWithTimeout      = scenario.WithTimeout
+	ScenarioWithStepTimeout  = scenario.WithStepTimeout
+	ScenarioWithVerbose      = scenario.WithVerbose
+	ScenarioWithOnStepStart  = scenario.WithOnStepStart
+	ScenarioWithOnStepEnd    = scenario.WithOnStepEnd
+	ScenarioWithSelfCorrect  = scenario.WithSelfCorrect
+	ScenarioWithChunkedParse = scenario.WithChunkedParse
+
+	ScenarioWithContinueOnFailure = scenario.WithContinueOnFailure
 
 	// Scenario reporter
 	NewScenarioConsoleReporter = scenario.NewConsoleReporter
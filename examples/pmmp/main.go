@@ -12,6 +12,9 @@ func main() {
 	// テストランナーを作成（デフォルトでConsoleReporterを使用し、自動的に結果を出力）
 	best.NewRunner(nil)
 
+	// 状態アサーションは失敗前に最大3秒間再チェックする（インベントリ・体力の同期待ち）
+	best.SetStateRetry(3*time.Second, 0)
+
 	// エージェント（テスト間で共有）
 	var agent *best.Agent
 
@@ -47,6 +50,10 @@ func main() {
 		})
 
 		best.It("体力が0より大きいべき", func(ctx *best.TestContext) {
+			// 最初の属性同期までは体力が0のため待機する
+			if err := agent.WaitForAttributes(5 * time.Second); err != nil {
+				panic(err)
+			}
 			agent.Expect().Health().ToBeAbove(0)
 		})
 
@@ -214,7 +221,8 @@ func main() {
 			if err := agent2.Connect(); err != nil {
 				panic(err)
 			}
-			time.Sleep(2 * time.Second)
+			// agent1のプレイヤーリストにagent2が現れるまで待つ
+			agent1.Expect().PlayerList().ToSeeJoin("BestTestBot2", 10*time.Second)
 		})
 
 		// スイート終了時に両方のエージェントを切断
@@ -278,18 +286,16 @@ func main() {
 		best.It("エージェント1がエージェント2に対してコマンドを実行できるべき", func(ctx *best.TestContext) {
 			// エージェント2のインベントリをクリア
 			agent2.Command("/clear")
-			time.Sleep(1 * time.Second) // インベントリ更新を待つ
 			agent2.Expect().Inventory().ToBeEmpty()
 
 			// エージェント1からエージェント2にダイアモンドを付与
 			// タイミングの問題を避けるため、先にリスナーを設定してからコマンド実行
 			go func() {
 				time.Sleep(500 * time.Millisecond)
-				agent1.Command("/give BestTestBot2 diamond 5")
+				agent1.Command(best.NewCommand("give").Arg(agent2.Username(), "diamond").Int(5).String())
 			}()
 			// PMPPは "Diamond" を含むメッセージを返す
 			agent1.Expect().Chat().ToReceive("Diamond", 5*time.Second, nil)
-			time.Sleep(1 * time.Second) // インベントリ更新を待つ
 			// アイテム名（"diamond", "minecraft:diamond"）、NetworkID（"335"）、完全なID（"item:335"）のいずれでもOK
 			agent2.Expect().Inventory().ToHaveItemCount("diamond", 5)
 		})
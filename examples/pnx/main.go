@@ -12,6 +12,9 @@ func main() {
 	// テストランナーを作成（デフォルトでConsoleReporterを使用し、自動的に結果を出力）
 	best.NewRunner(nil)
 
+	// 状態アサーションは失敗前に最大3秒間再チェックする（インベントリ・体力の同期待ち）
+	best.SetStateRetry(3*time.Second, 0)
+
 	// エージェント（テスト間で共有）
 	var agent *best.Agent
 
@@ -47,6 +50,10 @@ func main() {
 		})
 
 		best.It("体力が0より大きいべき", func(ctx *best.TestContext) {
+			// 最初の属性同期までは体力が0のため待機する
+			if err := agent.WaitForAttributes(5 * time.Second); err != nil {
+				panic(err)
+			}
 			agent.Expect().Health().ToBeAbove(0)
 		})
 
@@ -187,13 +194,16 @@ func main() {
 				time.Sleep(500 * time.Millisecond)
 				agent.Command("/scoreboard players set @s test_score 100")
 			}()
-			agent.Expect().Scoreboard().ToHaveScore("test_score", 100, 5*time.Second)
+			agent.Expect().Scoreboard().ToHaveMyScore("test_score", 100, 5*time.Second)
 		})
 
 		best.It("スコアを増加できるべき", func(ctx *best.TestContext) {
-			agent.Command("/scoreboard players add @s test_score 50")
+			go func() {
+				time.Sleep(500 * time.Millisecond)
+				agent.Command("/scoreboard players add @s test_score 50")
+			}()
 			// 100 + 50 = 150
-			agent.Expect().Scoreboard().ToHaveScoreAbove("test_score", 100, 5*time.Second)
+			agent.Expect().Scoreboard().ToIncreaseBy("test_score", 50, 5*time.Second)
 		})
 
 		best.It("スコアが範囲内にあるべき", func(ctx *best.TestContext) {
@@ -288,7 +298,8 @@ func main() {
 			if err := agent2.Connect(); err != nil {
 				panic(err)
 			}
-			time.Sleep(2 * time.Second)
+			// agent1のプレイヤーリストにagent2が現れるまで待つ
+			agent1.Expect().PlayerList().ToSeeJoin("MultiAgent2", 10*time.Second)
 		})
 
 		// スイート終了時に両方のエージェントを切断
@@ -360,9 +371,8 @@ func main() {
 			time.Sleep(500 * time.Millisecond) // インベントリ更新を待つ
 
 			// エージェント1からエージェント2にダイアモンドを付与
-			agent1.Command("/give MultiAgent2 diamond 5")
-			agent2.Command("/give MultiAgent1 bed 3")
-			time.Sleep(500 * time.Millisecond) // インベントリ更新を待つ
+			agent1.Command(best.NewCommand("give").Arg(agent2.Username(), "diamond").Int(5).String())
+			agent2.Command(best.NewCommand("give").Arg(agent1.Username(), "bed").Int(3).String())
 
 			agent1.Expect().Inventory().ToHaveItemCount("bed", 3)
 			agent2.Expect().Inventory().ToHaveItemCount("diamond", 5)
@@ -5,7 +5,9 @@ go 1.24.0
 require (
 	github.com/go-gl/mathgl v1.2.0
 	github.com/google/uuid v1.6.0
+	github.com/liushuangls/go-anthropic/v2 v2.17.0
 	github.com/sandertv/gophertunnel v1.54.0
+	github.com/sashabaranov/go-openai v1.41.2
 	gopkg.in/yaml.v3 v3.0.1
 )
 
@@ -16,9 +18,7 @@ require (
 	github.com/df-mc/jsonc v1.0.5 // indirect
 	github.com/go-jose/go-jose/v4 v4.1.3 // indirect
 	github.com/klauspost/compress v1.18.4 // indirect
-	github.com/liushuangls/go-anthropic/v2 v2.17.0 // indirect
 	github.com/sandertv/go-raknet v1.15.0 // indirect
-	github.com/sashabaranov/go-openai v1.41.2 // indirect
 	golang.org/x/net v0.50.0 // indirect
 	golang.org/x/oauth2 v0.35.0 // indirect
 	golang.org/x/text v0.34.0 // indirect
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/google/uuid"
@@ -18,7 +21,7 @@ import (
 // Chat sends a chat message
 func (a *Agent) Chat(message string) error {
 	if !a.isConnected.Load() {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 
 	// Use Text packet for chat messages (original implementation)
@@ -35,33 +38,301 @@ func (a *Agent) Chat(message string) error {
 }
 
 // Command sends a command to the server
-// Send method is determined by agent configuration (commandSendMethod)
+// Commands without a leading slash get the configured command prefix (default "/").
+// Send method is determined by agent configuration (commandSendMethod); commands with a
+// prefix other than "/" are typed in chat, since servers only parse those from chat messages.
 // Use Chat() or CommandOutput() assertions to wait for the response
 func (a *Agent) Command(cmd string) error {
-	// Ensure command has leading slash
-	if len(cmd) > 0 && cmd[0] != '/' {
-		cmd = "/" + cmd
+	return a.CommandContext(context.Background(), cmd)
+}
+
+// CommandContext sends a command like Command, unless ctx is already done
+// Sending does not block on the server, so ctx only bounds when the command may still be sent; use
+// CommandWithResponseContext to also bound the wait for the response.
+func (a *Agent) CommandContext(ctx context.Context, cmd string) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	// Send command based on configured method
-	if a.commandSendMethod == "request" {
-		return a.sendCommandViaRequest(cmd)
+	cmd, viaRequest := a.prepareCommand(cmd)
+	if viaRequest {
+		return a.sendCommandViaRequest(cmd, uuid.New())
 	}
 	return a.sendCommandViaText(cmd)
 }
 
+// macroArgPattern matches the positional placeholders $1, $2, ... in macro commands
+var macroArgPattern = regexp.MustCompile(`\$(\d+)`)
+
+// RunMacro sends the commands of a macro defined with WithMacros (or the config's macros section)
+// Placeholders $1, $2, ... are replaced with args; a placeholder without a matching argument is an
+// error, checked before anything is sent. Commands are sent in order and the first failure stops the macro.
+func (a *Agent) RunMacro(name string, args ...string) error {
+	commands, ok := a.macros[name]
+	if !ok {
+		return fmt.Errorf("unknown macro %q", name)
+	}
+
+	expanded := make([]string, 0, len(commands))
+	for _, cmd := range commands {
+		var missing string
+		cmd = macroArgPattern.ReplaceAllStringFunc(cmd, func(placeholder string) string {
+			index, _ := strconv.Atoi(placeholder[1:])
+			if index < 1 || index > len(args) {
+				missing = placeholder
+				return placeholder
+			}
+			return args[index-1]
+		})
+		if missing != "" {
+			return fmt.Errorf("macro %q: no argument for %s (got %d)", name, missing, len(args))
+		}
+		expanded = append(expanded, cmd)
+	}
+
+	for _, cmd := range expanded {
+		if err := a.Command(cmd); err != nil {
+			return fmt.Errorf("macro %q: %s: %w", name, cmd, err)
+		}
+	}
+	return nil
+}
+
+// prepareCommand applies the command prefix and reports whether the command is sent as a CommandRequest
+func (a *Agent) prepareCommand(cmd string) (string, bool) {
+	if !strings.HasPrefix(cmd, "/") {
+		prefix := a.commandPrefix
+		if prefix == "" {
+			prefix = "/"
+		}
+		if !strings.HasPrefix(cmd, prefix) {
+			cmd = prefix + cmd
+		}
+		if prefix != "/" {
+			return cmd, false
+		}
+	}
+
+	// Send command based on configured method
+	return cmd, a.commandSendMethod == "request"
+}
+
+// commandQuietPeriod is how long CommandWithResponse waits for further response lines in text mode
+const commandQuietPeriod = 250 * time.Millisecond
+
+// CommandWithResponse sends a command and waits for the server's response
+// With commandSendMethod "request", the CommandOutput answering this request (matched by its origin
+// UUID) is returned as soon as it arrives. Otherwise server messages are collected for up to the command
+// timeout; collection ends early on an error or "commands.*" message, or once no further message
+// arrives for a short quiet period.
+// Text replies carry nothing that ties them to the command, so in text mode any server message
+// arriving in that window (e.g. a broadcast) is attributed to it. Text-mode calls on one agent run
+// one at a time so they do not take each other's replies; use "request" when exact correlation matters.
+func (a *Agent) CommandWithResponse(cmd string) (*types.CommandOutput, error) {
+	return a.CommandWithResponseContext(context.Background(), cmd)
+}
+
+// CommandWithResponseContext sends a command and waits for the response like CommandWithResponse
+// The wait also ends with ctx's error when ctx is cancelled or its deadline passes before the
+// command timeout.
+func (a *Agent) CommandWithResponseContext(ctx context.Context, cmd string) (*types.CommandOutput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	cmd, viaRequest := a.prepareCommand(cmd)
+	if viaRequest {
+		return a.commandViaRequestWithResponse(ctx, cmd)
+	}
+	return a.commandViaTextWithResponse(ctx, cmd)
+}
+
+// pageHeaderPattern matches page headers such as "--- Showing help page 1 of 12 (/help <page>) ---" or "Page 2/5"
+var pageHeaderPattern = regexp.MustCompile(`(?i)page\s+(\d+)\s*(?:of|/)\s*(\d+)`)
+
+// CommandAllPages runs a paginated command such as /help on every page and aggregates the output
+// The page count is read from the first page's header; further pages are requested by appending
+// the page number ("/help 2"). At most maxPages pages are fetched (0 = all pages). Output without a
+// page header is returned as-is. The result fails if any page failed.
+func (a *Agent) CommandAllPages(cmd string, maxPages int) (*types.CommandOutput, error) {
+	first, err := a.CommandWithResponse(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	match := pageHeaderPattern.FindStringSubmatch(first.Output)
+	if match == nil {
+		return first, nil
+	}
+	total, _ := strconv.Atoi(match[2])
+	if maxPages > 0 {
+		total = min(total, maxPages)
+	}
+
+	result := *first
+	for page := 2; page <= total; page++ {
+		output, err := a.CommandWithResponse(fmt.Sprintf("%s %d", cmd, page))
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		result.Output += "\n" + output.Output
+		if !output.Success {
+			result.Success = false
+			result.StatusCode = output.StatusCode
+		}
+	}
+	return &result, nil
+}
+
+// commandViaRequestWithResponse sends a CommandRequest and waits for its CommandOutput
+func (a *Agent) commandViaRequestWithResponse(ctx context.Context, cmd string) (*types.CommandOutput, error) {
+	originID := uuid.New()
+
+	// Subscribe before sending so a fast response is not missed
+	outputs := make(chan *types.CommandOutput, 1)
+	listenerID := a.emitter.On(events.EventCommandOutput, func(data events.EventData) {
+		output, ok := data.(*types.CommandOutput)
+		if !ok || output.Origin != originID.String() {
+			return
+		}
+		select {
+		case outputs <- output:
+		default:
+		}
+	})
+	defer a.emitter.Off(events.EventCommandOutput, listenerID)
+
+	if err := a.sendCommandViaRequest(cmd, originID); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(a.commandTimeout)
+	defer timer.Stop()
+
+	select {
+	case output := <-outputs:
+		// The output is shared with other listeners, so truncate a copy
+		truncated := *output
+		truncated.Output = a.truncateOutput(strings.Split(output.Output, "\n"))
+		return &truncated, nil
+	case <-timer.C:
+		a.client.UntrackCommand(originID)
+		return nil, fmt.Errorf("%w: no CommandOutput for %q within %v", ErrCommandTimeout, cmd, a.commandTimeout)
+	case <-ctx.Done():
+		a.client.UntrackCommand(originID)
+		return nil, ctx.Err()
+	}
+}
+
+// commandViaTextWithResponse sends a command as chat and collects the server's reply messages
+// The replies are not correlated with the command (see CommandWithResponse).
+func (a *Agent) commandViaTextWithResponse(ctx context.Context, cmd string) (*types.CommandOutput, error) {
+	a.textCommandMu.Lock()
+	defer a.textCommandMu.Unlock()
+
+	messages := make(chan *types.ChatMessage, 32)
+	listenerID := a.emitter.On(events.EventChat, func(data events.EventData) {
+		msg, ok := data.(*types.ChatMessage)
+		if !ok || (msg.Type == "chat" && msg.Sender != "") {
+			// Player chat, including the agent's own echo, is not a command response
+			return
+		}
+		select {
+		case messages <- msg:
+		default:
+		}
+	})
+	defer a.emitter.Off(events.EventChat, listenerID)
+
+	if err := a.sendCommandViaText(cmd); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(a.commandTimeout)
+	defer timer.Stop()
+
+	output := &types.CommandOutput{Command: strings.TrimPrefix(cmd, "/"), Success: true}
+	var lines []string
+	var quiet <-chan time.Time
+	for {
+		select {
+		case msg := <-messages:
+			lines = append(lines, msg.Message)
+			output.Output = a.truncateOutput(lines)
+			if isCommandError(msg) {
+				output.Success = false
+				output.StatusCode = types.CommandStatusFailure
+				return output, nil
+			}
+			if strings.HasPrefix(msg.TranslationKey, "commands.") {
+				return output, nil
+			}
+			quiet = time.After(commandQuietPeriod)
+		case <-quiet:
+			return output, nil
+		case <-timer.C:
+			if len(lines) == 0 {
+				return nil, fmt.Errorf("%w: no response to %q within %v", ErrCommandTimeout, cmd, a.commandTimeout)
+			}
+			return output, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// truncateOutput joins output lines, keeping at most the configured maximum followed by a marker
+func (a *Agent) truncateOutput(lines []string) string {
+	if a.maxOutputLines <= 0 || len(lines) <= a.maxOutputLines {
+		return strings.Join(lines, "\n")
+	}
+	kept := append(lines[:a.maxOutputLines:a.maxOutputLines], fmt.Sprintf("... (%d more lines)", len(lines)-a.maxOutputLines))
+	return strings.Join(kept, "\n")
+}
+
+// commandErrorPhrases are lowercase fragments of common command failure messages
+var commandErrorPhrases = []string{
+	"unknown command",
+	"unknown or incomplete command",
+	"syntax error",
+	"do not have permission",
+	"don't have permission",
+	"no targets matched",
+	"player not found",
+	"usage:",
+}
+
+// isCommandError reports whether a server message looks like a command failure
+// Vanilla failures use commands.generic.* translations; plugin messages are matched by phrase or red color.
+func isCommandError(msg *types.ChatMessage) bool {
+	if strings.HasPrefix(msg.TranslationKey, "commands.generic.") {
+		return true
+	}
+	if strings.HasPrefix(msg.Message, "§c") || strings.HasPrefix(msg.Message, "§4") {
+		return true
+	}
+
+	text := strings.ToLower(msg.Message)
+	for _, phrase := range commandErrorPhrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
 // sendCommandViaText sends a command as a chat message (Text packet)
 func (a *Agent) sendCommandViaText(cmd string) error {
 	if !a.isConnected.Load() {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 	return a.Chat(cmd)
 }
 
-// sendCommandViaRequest sends a command via CommandRequest packet
-func (a *Agent) sendCommandViaRequest(cmd string) error {
+// sendCommandViaRequest sends a command via CommandRequest packet with the given origin UUID
+func (a *Agent) sendCommandViaRequest(cmd string, originID uuid.UUID) error {
 	if !a.isConnected.Load() {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 
 	// Remove leading slash for CommandRequest
@@ -70,24 +341,83 @@ func (a *Agent) sendCommandViaRequest(cmd string) error {
 		cmdLine = cmdLine[1:]
 	}
 
+	// Remember the origin UUID so the CommandOutput can be attributed to this command
+	a.client.TrackCommand(originID, cmdLine)
+
 	pk := &packet.CommandRequest{
 		CommandLine: cmdLine,
 		CommandOrigin: protocol.CommandOrigin{
 			Origin:         protocol.CommandOriginPlayer,
-			UUID:           uuid.New(),
+			UUID:           originID,
 			RequestID:      "",
 			PlayerUniqueID: a.state.RuntimeEntityID,
 		},
 		Internal: false,
 	}
 
-	return a.client.WritePacket(pk)
+	if err := a.client.WritePacket(pk); err != nil {
+		a.client.UntrackCommand(originID)
+		return err
+	}
+	return nil
+}
+
+// Respawn asks the server to respawn the agent after dying
+// The server answers with a Respawn packet, which emits EventRespawn and moves the agent to the
+// spawn point; wait for that event before acting again.
+func (a *Agent) Respawn() error {
+	if !a.isConnected.Load() {
+		return ErrNotConnected
+	}
+
+	entityID := uint64(a.State().RuntimeEntityID)
+	if err := a.client.WritePacket(&packet.Respawn{
+		State:           packet.RespawnStateClientReadyToSpawn,
+		EntityRuntimeID: entityID,
+	}); err != nil {
+		return err
+	}
+	return a.client.WritePacket(&packet.PlayerAction{
+		EntityRuntimeID: entityID,
+		ActionType:      protocol.PlayerActionRespawn,
+	})
 }
 
 // Goto teleports the player to the specified position
-func (a *Agent) Goto(pos types.Position) error {
-	cmd := fmt.Sprintf("/tp %s %.2f %.2f %.2f", a.Username(), pos.X, pos.Y, pos.Z)
-	return a.Command(cmd)
+// An optional dimension ("overworld", "nether", "the_end") teleports across dimensions using /execute in
+func (a *Agent) Goto(pos types.Position, dimension ...string) error {
+	tp := NewCommand("tp").Arg(a.DisplayName()).Float(pos.X).Float(pos.Y).Float(pos.Z)
+	if len(dimension) == 0 || dimension[0] == "" {
+		return a.Command(tp.String())
+	}
+
+	dim, err := normalizeDimension(dimension[0])
+	if err != nil {
+		return err
+	}
+	return a.Command(NewCommand("execute").Raw("in", dim, "run").Run(tp).String())
+}
+
+// MoveRelative teleports the player by an offset from its current position
+// The offset is applied server-side with ~ notation, so it does not depend on the locally tracked position.
+// The player is targeted with @s, so it works whatever name the server lists it under.
+func (a *Agent) MoveRelative(dx, dy, dz float64) error {
+	cmd := NewCommand("tp").Raw("@s").Relative(dx).Relative(dy).Relative(dz)
+	return a.Command(cmd.String())
+}
+
+// normalizeDimension converts a dimension name to the identifier accepted by /execute in
+func normalizeDimension(dimension string) (string, error) {
+	switch strings.TrimPrefix(strings.ToLower(dimension), "minecraft:") {
+	case "overworld":
+		return "overworld", nil
+	case "nether", "the_nether":
+		return "nether", nil
+	case "end", "the_end":
+		return "the_end", nil
+	default:
+		return "", fmt.Errorf("unknown dimension: %s (expected overworld, nether or the_end)", dimension)
+	}
 }
 
 // LookAt makes the player look at a specific position
@@ -102,25 +432,100 @@ func (a *Agent) LookAt(pos types.Position) error {
 	distance := math.Sqrt(dx*dx + dz*dz)
 	pitch := float32(-math.Atan2(dy, distance) * (180 / math.Pi))
 
-	// Send move packet with new rotation
-	pk := &packet.MovePlayer{
-		EntityRuntimeID: uint64(a.state.RuntimeEntityID),
-		Position:        mgl32.Vec3{float32(current.X), float32(current.Y), float32(current.Z)},
-		Pitch:           pitch,
-		Yaw:             yaw,
-		HeadYaw:         yaw,
-		Mode:            packet.MoveModeNormal,
-		OnGround:        a.state.IsOnGround,
-		Tick:            0,
+	return a.sendMovement(current, yaw, pitch)
+}
+
+// Turn rotates the player by the given yaw and pitch deltas (in degrees) from its current rotation
+// Yaw is wrapped to [-180, 180) and pitch is clamped to [-90, 90]
+func (a *Agent) Turn(deltaYaw, deltaPitch float32) error {
+	state := a.State()
+
+	yaw := float32(math.Mod(float64(state.Rotation.Yaw+deltaYaw)+180, 360))
+	if yaw < 0 {
+		yaw += 360
 	}
+	yaw -= 180
 
-	return a.client.WritePacket(pk)
+	pitch := state.Rotation.Pitch + deltaPitch
+	pitch = max(-90, min(90, pitch))
+
+	return a.sendMovement(state.Position, yaw, pitch)
+}
+
+// MovementMode selects how movement, rotation and block interactions are sent
+type MovementMode string
+
+// Movement modes
+const (
+	// MovementModeMovePlayer sends movement as MovePlayer packets (server-authoritative movement)
+	MovementModeMovePlayer MovementMode = "move_player"
+	// MovementModeAuthInput sends movement as PlayerAuthInput packets (client-authoritative movement)
+	MovementModeAuthInput MovementMode = "auth_input"
+)
+
+// Valid reports whether m is one of the known movement modes
+func (m MovementMode) Valid() bool {
+	return m == MovementModeMovePlayer || m == MovementModeAuthInput
+}
+
+// sendMovement sends the given position and rotation using the configured movement mode
+// and records the rotation in the local state
+func (a *Agent) sendMovement(pos types.Position, yaw, pitch float32) error {
+	var pk packet.Packet
+	if a.movementMode == MovementModeAuthInput {
+		pk = a.buildAuthInput(pos, yaw, pitch)
+	} else {
+		pk = &packet.MovePlayer{
+			EntityRuntimeID: uint64(a.state.RuntimeEntityID),
+			Position:        mgl32.Vec3{float32(pos.X), float32(pos.Y), float32(pos.Z)},
+			Pitch:           pitch,
+			Yaw:             yaw,
+			HeadYaw:         yaw,
+			Mode:            packet.MoveModeNormal,
+			OnGround:        a.state.IsOnGround,
+			Tick:            0,
+		}
+	}
+
+	if err := a.client.WritePacket(pk); err != nil {
+		return err
+	}
+	a.lastMovement.Store(time.Now().UnixNano())
+
+	// The server does not echo our own rotation back, so track it locally
+	a.mu.Lock()
+	a.state.Rotation = types.Rotation{Yaw: yaw, Pitch: pitch}
+	a.mu.Unlock()
+
+	return nil
+}
+
+// buildAuthInput builds a PlayerAuthInput packet for the given position and rotation
+// The delta is computed from the last known position; no movement keys are pressed
+func (a *Agent) buildAuthInput(pos types.Position, yaw, pitch float32) *packet.PlayerAuthInput {
+	current := a.Position()
+	target := mgl32.Vec3{float32(pos.X), float32(pos.Y), float32(pos.Z)}
+
+	return &packet.PlayerAuthInput{
+		Pitch:            pitch,
+		Yaw:              yaw,
+		HeadYaw:          yaw,
+		Position:         target,
+		InputData:        protocol.NewBitset(packet.PlayerAuthInputBitsetSize),
+		InputMode:        packet.InputModeMouse,
+		PlayMode:         packet.PlayModeNormal,
+		InteractionModel: packet.InteractionModelCrosshair,
+		InteractPitch:    pitch,
+		InteractYaw:      yaw,
+		Tick:             a.authInputTick.Add(1),
+		Delta:            target.Sub(mgl32.Vec3{float32(current.X), float32(current.Y), float32(current.Z)}),
+	}
 }
 
 // SendPacket sends a raw packet to the server
 func (a *Agent) SendPacket(pk packet.Packet) error {
 	if !a.isConnected.Load() {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 	return a.client.WritePacket(pk)
 }
@@ -0,0 +1,65 @@
+package agent
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRunMacro(t *testing.T) {
+	macros := map[string][]string{
+		"give":  {"/give $1 diamond $2"},
+		"reset": {"/clear", "/tp 0 64 0"},
+	}
+
+	tests := []struct {
+		name    string
+		macro   string
+		args    []string
+		wantErr string
+		notConn bool
+	}{
+		{name: "unknown macro", macro: "missing", wantErr: `unknown macro "missing"`},
+		{name: "missing argument", macro: "give", args: []string{"Steve"}, wantErr: `macro "give": no argument for $2 (got 1)`},
+		{name: "sent while disconnected", macro: "reset", notConn: true},
+		{name: "all arguments given", macro: "give", args: []string{"Steve", "5"}, notConn: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAgent(WithMacros(macros))
+			err := a.RunMacro(tt.macro, tt.args...)
+			switch {
+			case tt.notConn:
+				if !errors.Is(err, ErrNotConnected) {
+					t.Errorf("RunMacro error = %v, want ErrNotConnected", err)
+				}
+			case err == nil || err.Error() != tt.wantErr:
+				t.Errorf("RunMacro error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTruncateOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxLines int
+		lines    []string
+		want     string
+	}{
+		{"unlimited", 0, []string{"a", "b", "c"}, "a\nb\nc"},
+		{"within limit", 3, []string{"a", "b", "c"}, "a\nb\nc"},
+		{"over limit", 2, []string{"a", "b", "c", "d"}, "a\nb\n... (2 more lines)"},
+		{"no lines", 2, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Agent{maxOutputLines: tt.maxLines}
+			lines := append([]string(nil), tt.lines...)
+			if got := a.truncateOutput(lines); got != tt.want {
+				t.Errorf("truncateOutput = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
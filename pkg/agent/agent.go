@@ -1,16 +1,21 @@
 package agent
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 
+	"github.com/gollilla/best/pkg/assertions"
 	bestevents "github.com/gollilla/best/pkg/events"
 	bestprotocol "github.com/gollilla/best/pkg/protocol"
 	"github.com/gollilla/best/pkg/state"
@@ -28,15 +33,32 @@ type Agent struct {
 	hasSpawned  atomic.Bool
 	emitter     *bestevents.Emitter
 
+	attributesSynced atomic.Bool  // set once the first health/hunger attribute update arrives
+	deaths           atomic.Int32 // deaths since connecting
+	heldSlot         atomic.Int32 // selected hotbar slot
+
 	// Agent features
 	commandPrefix     string
 	commandSendMethod string        // "text" or "request"
-	commandTimeout    time.Duration // assertion wait timeout
+	commandTimeout    time.Duration // command response wait timeout
+	maxOutputLines    int           // command output line cap (0 = unlimited)
+	movementMode      MovementMode  // "move_player" or "auth_input"
+
+	macros map[string][]string // named command sequences for RunMacro
+
+	// Connection
+	maxIdentityRotations    int           // fresh-identity retries when the login conflicts with a lingering session
+	identityRotationBackoff time.Duration // wait before each identity rotation retry
+	identityRotationRename  bool          // also number the display name on each identity rotation
+	keepAliveInterval       time.Duration // anti-idle packet interval (0 = disabled)
+	maxEntities             int           // tracked entity cap (0 = unlimited)
+	attributesTimeout       time.Duration // Connect waits for the first attribute sync (0 = disabled)
+	eventBufferSize         int           // events buffered per asynchronous listener
 
 	// Player state
 	inventory []types.InventoryItem
 	effects   []types.Effect
-	entities  map[int64]types.Entity
+	entities  map[int64]types.Entity // keyed by unique ID, which RemoveActor refers to
 	scores    map[string]int32
 	tags      []string
 	hunger    float32
@@ -45,11 +67,21 @@ type Agent struct {
 	// World management
 	world *world.World
 
+	// Recent chat messages and titles for history-backed assertions
+	historySize  int
+	chatHistory  *eventHistory[types.ChatMessage]
+	titleHistory *eventHistory[types.TitleDisplay]
+
 	// Internal
-	pendingForms map[int32]types.Form
-	mu           sync.RWMutex
-	ctx          context.Context
-	cancel       context.CancelFunc
+	authInputTick atomic.Uint64 // tick counter for PlayerAuthInput packets
+	lastMovement  atomic.Int64  // unix nanoseconds of the last movement packet sent
+	pendingForms  map[int32]types.Form
+	expect        *assertions.AssertionContext
+	expectOnce    sync.Once
+	textCommandMu sync.Mutex // serializes text-mode CommandWithResponse calls
+	mu            sync.RWMutex
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 // NewAgent creates a new agent with the given options
@@ -59,19 +91,24 @@ func NewAgent(opts ...AgentOption) *Agent {
 	a := &Agent{
 		options:           DefaultOptions(),
 		state:             state.CreateInitialState(),
-		emitter:           bestevents.NewEmitter(),
 		world:             world.NewWorld(),
 		ctx:               ctx,
 		cancel:            cancel,
-		commandPrefix:     "!",
+		commandPrefix:     "/",
 		commandSendMethod: "text",
 		commandTimeout:    5 * time.Second,
+		movementMode:      MovementModeMovePlayer,
+		maxEntities:       DefaultMaxEntities,
+		eventBufferSize:   bestevents.DefaultBufferSize,
+		historySize:       DefaultHistorySize,
 		entities:          make(map[int64]types.Entity),
 		scores:            make(map[string]int32),
 		pendingForms:      make(map[int32]types.Form),
 		tags:              make([]string, 0),
 		inventory:         make([]types.InventoryItem, 0),
 		effects:           make([]types.Effect, 0),
+
+		identityRotationBackoff: DefaultIdentityRotationBackoff,
 	}
 
 	// Apply options
@@ -84,9 +121,26 @@ func NewAgent(opts ...AgentOption) *Agent {
 		a.username = a.options.Username
 	}
 
+	a.emitter = bestevents.NewEmitterWithBuffer(a.eventBufferSize)
+	a.chatHistory = newEventHistory[types.ChatMessage](a.historySize)
+	a.titleHistory = newEventHistory[types.TitleDisplay](a.historySize)
+
 	// Create protocol client
 	a.client = bestprotocol.NewClient(a.emitter, a.state, a.username)
 
+	// Record chat messages and titles so assertions can check them after the fact
+	a.emitter.OnSync(bestevents.EventChat, func(data bestevents.EventData) {
+		if msg, ok := data.(*types.ChatMessage); ok {
+			a.chatHistory.add(*msg)
+		}
+	})
+
+	a.emitter.OnSync(bestevents.EventTitle, func(data bestevents.EventData) {
+		if title, ok := data.(*types.TitleDisplay); ok {
+			a.titleHistory.add(*title)
+		}
+	})
+
 	// Listen for form events and store them.
 	// OnSync ensures pendingForms is updated before Emit returns, so that
 	// callers who receive the form via another listener can immediately call
@@ -102,26 +156,31 @@ func NewAgent(opts ...AgentOption) *Agent {
 		a.mu.Unlock()
 	})
 
-	// Listen for inventory updates and store them
-	a.emitter.On(bestevents.EventInventoryUpdate, func(data bestevents.EventData) {
+	// Listen for inventory updates and store them.
+	// OnSync keeps snapshots in packet order so EventInventoryChanged diffs are accurate.
+	a.emitter.OnSync(bestevents.EventInventoryUpdate, func(data bestevents.EventData) {
 		items, ok := data.([]types.InventoryItem)
 		if !ok {
 			return
 		}
 
 		a.mu.Lock()
+		previous := a.inventory
 		a.inventory = items
 		a.mu.Unlock()
+
+		a.emitInventoryChanged(previous, items)
 	})
 
 	// Listen for inventory slot updates
-	a.emitter.On(bestevents.EventInventorySlotUpdate, func(data bestevents.EventData) {
+	a.emitter.OnSync(bestevents.EventInventorySlotUpdate, func(data bestevents.EventData) {
 		item, ok := data.(types.InventoryItem)
 		if !ok {
 			return
 		}
 
 		a.mu.Lock()
+		previous := slices.Clone(a.inventory)
 		// Update or add the item in the inventory
 		found := false
 		for i, existingItem := range a.inventory {
@@ -134,37 +193,330 @@ func NewAgent(opts ...AgentOption) *Agent {
 		if !found {
 			a.inventory = append(a.inventory, item)
 		}
+		current := slices.Clone(a.inventory)
+		a.mu.Unlock()
+
+		a.emitInventoryChanged(previous, current)
+	})
+
+	// Track hunger and the first attribute sync
+	a.emitter.OnSync(bestevents.EventHealthUpdate, func(_ bestevents.EventData) {
+		a.attributesSynced.Store(true)
+	})
+	a.emitter.OnSync(bestevents.EventHungerUpdate, func(data bestevents.EventData) {
+		if hunger, ok := data.(float32); ok {
+			a.mu.Lock()
+			a.hunger = hunger
+			a.mu.Unlock()
+		}
+		a.attributesSynced.Store(true)
+	})
+
+	// Count deaths
+	a.emitter.OnSync(bestevents.EventDeath, func(_ bestevents.EventData) {
+		a.deaths.Add(1)
+	})
+
+	// Track the selected hotbar slot
+	a.emitter.OnSync(bestevents.EventHeldSlotUpdate, func(data bestevents.EventData) {
+		if slot, ok := data.(int32); ok {
+			a.heldSlot.Store(slot)
+		}
+	})
+
+	// Track active effects and publish the full list on every change
+	a.emitter.OnSync(bestevents.EventEffectAdd, func(data bestevents.EventData) {
+		effect, ok := data.(*types.Effect)
+		if !ok {
+			return
+		}
+
+		a.mu.Lock()
+		a.effects = slices.DeleteFunc(slices.Clone(a.effects), func(e types.Effect) bool {
+			return e.ID == effect.ID
+		})
+		a.effects = append(a.effects, *effect)
+		current := slices.Clone(a.effects)
+		a.mu.Unlock()
+
+		a.emitter.Emit(bestevents.EventEffectUpdate, current)
+	})
+
+	a.emitter.OnSync(bestevents.EventEffectRemove, func(data bestevents.EventData) {
+		effect, ok := data.(*types.Effect)
+		if !ok {
+			return
+		}
+
+		a.mu.Lock()
+		a.effects = slices.DeleteFunc(slices.Clone(a.effects), func(e types.Effect) bool {
+			return e.ID == effect.ID
+		})
+		current := slices.Clone(a.effects)
 		a.mu.Unlock()
+
+		a.emitter.Emit(bestevents.EventEffectUpdate, current)
+	})
+
+	// Track spawned and removed entities.
+	// OnSync keeps the map consistent with packet order.
+	a.emitter.OnSync(bestevents.EventEntityAdd, func(data bestevents.EventData) {
+		entity, ok := data.(*types.Entity)
+		if !ok {
+			return
+		}
+
+		a.mu.Lock()
+		a.entities[entity.UniqueID] = *entity
+		a.evictEntities()
+		a.mu.Unlock()
+	})
+
+	a.emitter.OnSync(bestevents.EventEntityRemove, func(data bestevents.EventData) {
+		uniqueID, ok := data.(int64)
+		if !ok {
+			return
+		}
+
+		a.mu.Lock()
+		delete(a.entities, uniqueID)
+		a.mu.Unlock()
+	})
+
+	a.emitter.OnSync(bestevents.EventEntityMove, func(data bestevents.EventData) {
+		move, ok := data.(*types.EntityMove)
+		if !ok {
+			return
+		}
+
+		// Entities are keyed by unique ID, but moves name the runtime ID
+		a.mu.Lock()
+		for id, entity := range a.entities {
+			if entity.RuntimeID == move.RuntimeID {
+				entity.Position = move.Apply(entity.Position)
+				a.entities[id] = entity
+				break
+			}
+		}
+		a.mu.Unlock()
+	})
+
+	// Name block runtime IDs with the palette of the server being connected to
+	a.emitter.OnSync(bestevents.EventBlockPalette, func(data bestevents.EventData) {
+		palette, ok := data.(map[uint32]string)
+		if !ok {
+			return
+		}
+		registry := a.world.Registry()
+		registry.Clear()
+		for runtimeID, name := range palette {
+			registry.Register(runtimeID, name)
+		}
+	})
+
+	// Keep the world in sync with loaded chunks and block updates
+	a.emitter.OnSync(bestevents.EventChunkLoaded, func(data bestevents.EventData) {
+		if chunk, ok := data.(*world.Chunk); ok {
+			a.world.SetChunk(chunk.Position, chunk)
+		}
+	})
+
+	a.emitter.OnSync(bestevents.EventSubChunkLoaded, func(data bestevents.EventData) {
+		if chunk, ok := data.(*world.Chunk); ok {
+			a.world.SetSubChunks(chunk)
+		}
+	})
+
+	a.emitter.OnSync(bestevents.EventBlockUpdate, func(data bestevents.EventData) {
+		if update, ok := data.(*types.BlockUpdate); ok {
+			_ = a.world.SetBlockAt(update.Position, uint32(update.RuntimeID))
+		}
 	})
 
 	return a
 }
 
+// evictEntities drops the entities farthest from the agent while more than maxEntities are tracked
+// a.mu must be held.
+func (a *Agent) evictEntities() {
+	excess := len(a.entities) - a.maxEntities
+	if a.maxEntities <= 0 || excess <= 0 {
+		return
+	}
+
+	pos := a.state.Position
+	ids := make([]int64, 0, len(a.entities))
+	for id := range a.entities {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(x, y int64) int {
+		dx := state.DistanceToSquared(pos, a.entities[x].Position)
+		dy := state.DistanceToSquared(pos, a.entities[y].Position)
+		if c := cmp.Compare(dy, dx); c != 0 {
+			return c
+		}
+		return cmp.Compare(x, y)
+	})
+	for _, id := range ids[:excess] {
+		delete(a.entities, id)
+	}
+}
+
+// emitInventoryChanged emits EventInventoryChanged if the item totals differ between snapshots
+func (a *Agent) emitInventoryChanged(previous, current []types.InventoryItem) {
+	change := diffInventory(previous, current)
+	if len(change.Added) == 0 && len(change.Removed) == 0 {
+		return
+	}
+	a.emitter.Emit(bestevents.EventInventoryChanged, change)
+}
+
+// diffInventory computes per-item count changes between two inventory snapshots
+// Items are compared by ID across all slots, so moving an item between slots is not a change
+func diffInventory(previous, current []types.InventoryItem) *types.InventoryChange {
+	counts := make(map[string]int32)
+	var order []string
+	add := func(items []types.InventoryItem, sign int32) {
+		for _, item := range items {
+			if item.ID == "" {
+				continue
+			}
+			if _, seen := counts[item.ID]; !seen {
+				order = append(order, item.ID)
+			}
+			counts[item.ID] += sign * item.Count
+		}
+	}
+	add(current, 1)
+	add(previous, -1)
+
+	change := &types.InventoryChange{
+		Previous: previous,
+		Current:  current,
+	}
+	for _, id := range order {
+		switch delta := counts[id]; {
+		case delta > 0:
+			change.Added = append(change.Added, types.InventoryItem{ID: id, Count: delta})
+		case delta < 0:
+			change.Removed = append(change.Removed, types.InventoryItem{ID: id, Count: -delta})
+		}
+	}
+	return change
+}
+
 // Connect establishes connection to the Minecraft server
 func (a *Agent) Connect() error {
+	return a.ConnectContext(context.Background())
+}
+
+// ConnectContext connects like Connect, giving up on identity rotation when ctx is done
+func (a *Agent) ConnectContext(ctx context.Context) error {
 	if a.isConnected.Load() {
-		return fmt.Errorf("already connected")
+		return ErrAlreadyConnected
+	}
+	if !a.movementMode.Valid() {
+		return fmt.Errorf("%w: %q", ErrUnknownMovementMode, a.movementMode)
 	}
 
 	// Create new context for this connection
 	// This is important for reconnections after disconnect
 	a.ctx, a.cancel = context.WithCancel(context.Background())
 
-	if err := a.client.Connect(a.options); err != nil {
+	if err := a.connectWithIdentityRotation(ctx); err != nil {
 		return err
 	}
 
 	a.isConnected.Store(true)
 
 	// Perform spawn sequence after connection is established
-	if err := a.client.DoSpawn(); err != nil {
+	// A failed spawn leaves an unusable connection, so close it to allow a clean retry
+	if err := a.client.DoSpawn(a.options.SpawnTimeout); err != nil {
+		_ = a.Disconnect()
 		return err
 	}
 
 	a.hasSpawned.Store(true)
+
+	if a.attributesTimeout > 0 {
+		if err := a.WaitForAttributes(a.attributesTimeout); err != nil {
+			_ = a.Disconnect()
+			return err
+		}
+	}
+
+	if a.keepAliveInterval > 0 {
+		go a.keepAlive(a.ctx, a.keepAliveInterval)
+	}
+
 	return nil
 }
 
+// keepAlive re-sends the current position and rotation once the agent has sent no movement for
+// a full interval, so idle-kick plugins see the agent as active during long waits without adding
+// traffic while it is already moving. It stops when the connection context is cancelled.
+func (a *Agent) keepAlive(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !a.isConnected.Load() {
+				return
+			}
+			if time.Since(time.Unix(0, a.lastMovement.Load())) < interval {
+				continue
+			}
+			state := a.State()
+			_ = a.sendMovement(state.Position, state.Rotation.Yaw, state.Rotation.Pitch)
+		}
+	}
+}
+
+// connectWithIdentityRotation dials the server, retrying with a fresh identity (XUID and UUID)
+// when the login is rejected because the previous session is still active
+// The number of retries is limited by WithIdentityRotation (0 = no retries) and the wait between
+// them by WithIdentityRotationBackoff. The display name is kept unless WithIdentityRotationRename
+// is set, in which case the agent's username follows the name it logged in with.
+func (a *Agent) connectWithIdentityRotation(ctx context.Context) error {
+	opts := a.options
+	err := a.client.Connect(opts)
+
+	for attempt := 1; err != nil && attempt <= a.maxIdentityRotations && bestprotocol.IsSessionConflict(err); attempt++ {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("identity rotation cancelled after %d attempt(s): %w", attempt, err)
+		case <-time.After(a.identityRotationBackoff):
+		}
+
+		opts.XUID = bestprotocol.GenerateXUID()
+		opts.Identity = uuid.New().String()
+		if a.identityRotationRename {
+			opts.Username = rotatedUsername(a.options.Username, attempt)
+		}
+		err = a.client.Connect(opts)
+	}
+
+	if err == nil && opts.Username != "" {
+		a.username = opts.Username
+	}
+	return err
+}
+
+// rotatedUsername derives the display name used for the given identity rotation attempt
+// The base name is shortened by whole characters so the result stays within the 16 character name limit.
+func rotatedUsername(base string, attempt int) string {
+	suffix := fmt.Sprintf("_%d", attempt)
+	runes := []rune(base)
+	if keep := maxUsernameLength - len(suffix); len(runes) > keep {
+		base = string(runes[:max(0, keep)])
+	}
+	return base + suffix
+}
+
 // Disconnect closes the connection
 func (a *Agent) Disconnect() error {
 	if !a.isConnected.Load() {
@@ -183,12 +535,22 @@ func (a *Agent) Disconnect() error {
 	// Always reset state, even if disconnect had an error
 	a.isConnected.Store(false)
 	a.hasSpawned.Store(false)
-	a.state = state.CreateInitialState()
+	a.attributesSynced.Store(false)
+	a.deaths.Store(0)
+	a.heldSlot.Store(0)
+	// Reset in place: the protocol client keeps writing to the same state after reconnecting
+	*a.state = *state.CreateInitialState()
 
-	// Clear pending forms
+	// Clear pending forms and entities
 	a.mu.Lock()
 	a.pendingForms = make(map[int32]types.Form)
+	a.entities = make(map[int64]types.Entity)
+	a.effects = nil
+	a.hunger = 0
 	a.mu.Unlock()
+	a.world.Clear()
+	// Messages from the old session must not satisfy ToHaveSeen after reconnecting
+	a.ClearHistory()
 
 	// Wait for server-side session cleanup
 	// This prevents "Logged in from other location" errors when reconnecting
@@ -209,6 +571,11 @@ func (a *Agent) IsConnected() bool {
 	return a.isConnected.Load()
 }
 
+// IsSpawned returns whether the agent has completed the spawn sequence
+func (a *Agent) IsSpawned() bool {
+	return a.hasSpawned.Load()
+}
+
 // Position returns the current position
 func (a *Agent) Position() types.Position {
 	a.mu.RLock()
@@ -223,6 +590,35 @@ func (a *Agent) Health() float32 {
 	return a.state.Health
 }
 
+// GetExperienceLevel returns the current experience level
+func (a *Agent) GetExperienceLevel() int32 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.state.Experience.Level
+}
+
+// GetTime returns the world time in ticks
+// Use types.TimeOfDay or types.IsNight to interpret it.
+func (a *Agent) GetTime() int32 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.state.Time
+}
+
+// GetWeather returns the current weather: "clear", "rain" or "thunder"
+func (a *Agent) GetWeather() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.state.Weather
+}
+
+// HealthHearts returns the current health as hearts, e.g. "♥♥♥♡♡♡♡♡♡♡ (5/20)"
+func (a *Agent) HealthHearts() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return types.FormatHearts(a.state.Health, a.state.MaxHealth)
+}
+
 // Gamemode returns the current gamemode
 func (a *Agent) Gamemode() int32 {
 	a.mu.RLock()
@@ -230,6 +626,34 @@ func (a *Agent) Gamemode() int32 {
 	return a.state.Gamemode
 }
 
+// Clone creates a new, unconnected agent with the same server and agent settings but a different username
+// The clone gets its own generated XUID and does not record the session. Use it to add players
+// to multi-agent tests without repeating every option.
+func (a *Agent) Clone(username string) *Agent {
+	return NewAgent(func(c *Agent) {
+		c.options = a.options
+		c.options.Username = username
+		c.options.XUID = ""
+		c.options.SessionRecordPath = ""
+		c.username = username
+
+		c.commandPrefix = a.commandPrefix
+		c.commandSendMethod = a.commandSendMethod
+		c.commandTimeout = a.commandTimeout
+		c.maxOutputLines = a.maxOutputLines
+		c.movementMode = a.movementMode
+		c.macros = a.macros
+		c.maxIdentityRotations = a.maxIdentityRotations
+		c.identityRotationBackoff = a.identityRotationBackoff
+		c.identityRotationRename = a.identityRotationRename
+		c.keepAliveInterval = a.keepAliveInterval
+		c.maxEntities = a.maxEntities
+		c.eventBufferSize = a.eventBufferSize
+		c.historySize = a.historySize
+		c.attributesTimeout = a.attributesTimeout
+	})
+}
+
 // State returns a copy of the current player state
 func (a *Agent) State() types.PlayerState {
 	a.mu.RLock()
@@ -255,7 +679,7 @@ func (a *Agent) GetEffects() []types.Effect {
 	return effects
 }
 
-// GetEntities returns a copy of nearby entities
+// GetEntities returns a copy of nearby entities, sorted by runtime ID
 func (a *Agent) GetEntities() []types.Entity {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
@@ -263,9 +687,46 @@ func (a *Agent) GetEntities() []types.Entity {
 	for _, entity := range a.entities {
 		entities = append(entities, entity)
 	}
+	slices.SortFunc(entities, func(x, y types.Entity) int {
+		return cmp.Compare(x.RuntimeID, y.RuntimeID)
+	})
 	return entities
 }
 
+// GetNearbyEntities returns the entities within distance blocks of the agent, sorted by runtime ID
+func (a *Agent) GetNearbyEntities(distance float64) []types.Entity {
+	pos := a.Position()
+	return slices.DeleteFunc(a.GetEntities(), func(entity types.Entity) bool {
+		return state.DistanceToSquared(pos, entity.Position) > distance*distance
+	})
+}
+
+// GetEntitiesByType returns the entities of the given type (e.g. "minecraft:zombie"), sorted by runtime ID
+func (a *Agent) GetEntitiesByType(entityType string) []types.Entity {
+	return slices.DeleteFunc(a.GetEntities(), func(entity types.Entity) bool {
+		return entity.Type != entityType
+	})
+}
+
+// GetNearestEntity returns the entity of the given type closest to the agent, or nil if there is none
+// An empty entityType matches any entity. Ties are broken by the lower runtime ID.
+func (a *Agent) GetNearestEntity(entityType string) *types.Entity {
+	pos := a.Position()
+
+	var nearest *types.Entity
+	nearestDist := math.MaxFloat64
+	for _, entity := range a.GetEntities() {
+		if entityType != "" && entity.Type != entityType {
+			continue
+		}
+		if dist := state.DistanceToSquared(pos, entity.Position); dist < nearestDist {
+			nearest = &entity
+			nearestDist = dist
+		}
+	}
+	return nearest
+}
+
 // GetScore returns the agent's current score in the specified objective
 // Returns nil if the score is not found
 func (a *Agent) GetScore(objectiveName string) *int32 {
@@ -351,6 +812,34 @@ func (a *Agent) GetAllScores(objectiveName string) []types.ScoreboardEntry {
 	return scores
 }
 
+// GetPlayerList returns the players currently in the server's player list, sorted by name
+// The list is the server's player list (tab list) and includes the agent itself.
+func (a *Agent) GetPlayerList() []types.PlayerListEntry {
+	return a.client.PlayerList()
+}
+
+// PacketCount returns how many packets with the given ID were received since connecting
+// It counts raw packets, e.g. agent.PacketCount(packet.IDSetScore), for protocol-level tests.
+func (a *Agent) PacketCount(packetID uint32) int {
+	return a.client.PacketCount(packetID)
+}
+
+// GetBossBars returns the boss bars currently shown to the agent, sorted by boss entity ID
+func (a *Agent) GetBossBars() []types.BossBar {
+	return a.client.BossBars()
+}
+
+// DisplayName returns the agent's name as listed by the server
+// Servers may assign a different name than the requested username (e.g. suffixing duplicates),
+// so use this for commands targeting the agent. It falls back to the username until the
+// server has listed the agent.
+func (a *Agent) DisplayName() string {
+	if entry, ok := a.client.OwnPlayerListEntry(); ok && entry.Name != "" {
+		return entry.Name
+	}
+	return a.username
+}
+
 // GetTags returns a copy of player tags
 func (a *Agent) GetTags() []string {
 	a.mu.RLock()
@@ -367,6 +856,28 @@ func (a *Agent) GetHunger() float32 {
 	return a.hunger
 }
 
+// GetDeathCount returns how often the agent has died since connecting
+func (a *Agent) GetDeathCount() int {
+	return int(a.deaths.Load())
+}
+
+// GetLastDeath returns the agent's most recent death since connecting
+// Unlike the death returned by Expect().Death().ToOccur, it includes a cause the server
+// sent after reporting health 0.
+func (a *Agent) GetLastDeath() (types.Death, bool) {
+	return a.client.LastDeath()
+}
+
+// HeldSlot returns the selected hotbar slot (0-8)
+func (a *Agent) HeldSlot() int32 {
+	return a.heldSlot.Load()
+}
+
+// HeldItem returns the item in the selected hotbar slot, or nil if the slot is empty
+func (a *Agent) HeldItem() *types.InventoryItem {
+	return a.itemInSlot(a.heldSlot.Load())
+}
+
 // GetPermissionLevel returns the current permission level
 func (a *Agent) GetPermissionLevel() int32 {
 	return a.state.PermissionLevel
@@ -377,6 +888,12 @@ func (a *Agent) World() *world.World {
 	return a.world
 }
 
+// GetBlockAt returns the block at the given position from the decoded chunks
+// It returns false if the chunk containing the position has not been loaded.
+func (a *Agent) GetBlockAt(pos types.Position) (*types.Block, bool) {
+	return a.world.GetBlockAt(pos)
+}
+
 // Emitter returns the event emitter for listening to events
 func (a *Agent) Emitter() *bestevents.Emitter {
 	return a.emitter
@@ -399,6 +916,182 @@ func (a *Agent) WaitForSpawn(ctx context.Context) error {
 	return err
 }
 
+// StartReplay marks the agent as connected and spawned without dialing a server,
+// so that recorded packets can be fed to it with ReplayPacket
+func (a *Agent) StartReplay(info bestprotocol.SessionInfo) {
+	a.ctx, a.cancel = context.WithCancel(context.Background())
+
+	a.mu.Lock()
+	a.client.StartReplay(info)
+	a.mu.Unlock()
+
+	a.isConnected.Store(true)
+	a.hasSpawned.Store(true)
+	a.emitter.Emit(bestevents.EventSpawn, nil)
+}
+
+// ReplayPacket handles a recorded packet as if it had been received from the server
+func (a *Agent) ReplayPacket(pk packet.Packet) {
+	a.client.HandlePacket(pk)
+}
+
+// WaitForHealthStable waits until no health update has occurred for quietPeriod
+// and returns the settled health. It fails if health keeps changing until timeout.
+func (a *Agent) WaitForHealthStable(quietPeriod time.Duration, timeout time.Duration) (float32, error) {
+	updated := make(chan struct{}, 1)
+	handlerID := a.emitter.OnSync(bestevents.EventHealthUpdate, func(_ bestevents.EventData) {
+		select {
+		case updated <- struct{}{}:
+		default:
+		}
+	})
+	defer a.emitter.Off(bestevents.EventHealthUpdate, handlerID)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	quiet := time.NewTimer(quietPeriod)
+	defer quiet.Stop()
+
+	for {
+		select {
+		case <-updated:
+			quiet.Reset(quietPeriod)
+		case <-quiet.C:
+			return a.Health(), nil
+		case <-deadline.C:
+			return a.Health(), fmt.Errorf("health did not stabilize within %v", timeout)
+		case <-a.ctx.Done():
+			return a.Health(), a.ctx.Err()
+		}
+	}
+}
+
+// WaitForAttributes waits until the first health or hunger attribute update has been processed
+// Health and hunger read as zero until then, so call this before asserting on them right after
+// connecting. It returns immediately if the attributes were already synced.
+func (a *Agent) WaitForAttributes(timeout time.Duration) error {
+	synced := make(chan struct{}, 1)
+	notify := func(_ bestevents.EventData) {
+		select {
+		case synced <- struct{}{}:
+		default:
+		}
+	}
+	healthID := a.emitter.OnSync(bestevents.EventHealthUpdate, notify)
+	defer a.emitter.Off(bestevents.EventHealthUpdate, healthID)
+	hungerID := a.emitter.OnSync(bestevents.EventHungerUpdate, notify)
+	defer a.emitter.Off(bestevents.EventHungerUpdate, hungerID)
+
+	if a.attributesSynced.Load() {
+		return nil
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-synced:
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("attributes not received within %v", timeout)
+	case <-a.ctx.Done():
+		return a.ctx.Err()
+	}
+}
+
+// PollState samples the player state every interval until fn returns true
+// It is an escape hatch for state changes the event model does not report cleanly; fn may also read
+// other agent state (e.g. GetTags) since it runs on the caller's goroutine. The state is checked once
+// immediately, and PollState fails if fn still returns false after timeout.
+func (a *Agent) PollState(interval time.Duration, fn func(types.PlayerState) bool, timeout time.Duration) error {
+	if fn(a.State()) {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if fn(a.State()) {
+				return nil
+			}
+		case <-deadline.C:
+			// One last sample so a change right at the deadline still counts
+			if fn(a.State()) {
+				return nil
+			}
+			return fmt.Errorf("state condition not met within %v", timeout)
+		case <-a.ctx.Done():
+			return a.ctx.Err()
+		}
+	}
+}
+
+// WaitTicks waits until the server has advanced n game ticks
+// Ticks are counted from tick-stamped packets (actor/attribute updates and SetTime), so the wait
+// follows the server's pace rather than wall-clock time. Each source is counted separately and the
+// fastest one wins; a source going backwards (e.g. /time set) is re-based. It fails after timeout,
+// which also happens if the server sends no tick-stamped packets.
+func (a *Agent) WaitTicks(n int, timeout time.Duration) error {
+	if n <= 0 {
+		return nil
+	}
+
+	var mu sync.Mutex
+	start := make(map[string]uint64)
+	var elapsed uint64
+
+	advanced := make(chan struct{}, 1)
+	handlerID := a.emitter.OnSync(bestevents.EventServerTick, func(data bestevents.EventData) {
+		tick, ok := data.(*types.ServerTick)
+		if !ok {
+			return
+		}
+
+		mu.Lock()
+		first, seen := start[tick.Source]
+		if !seen || tick.Tick < first {
+			start[tick.Source] = tick.Tick - min(tick.Tick, elapsed)
+			mu.Unlock()
+			return
+		}
+		elapsed = max(elapsed, tick.Tick-first)
+		mu.Unlock()
+
+		select {
+		case advanced <- struct{}{}:
+		default:
+		}
+	})
+	defer a.emitter.Off(bestevents.EventServerTick, handlerID)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-advanced:
+			mu.Lock()
+			done := elapsed >= uint64(n)
+			mu.Unlock()
+			if done {
+				return nil
+			}
+		case <-deadline.C:
+			mu.Lock()
+			observed := elapsed
+			mu.Unlock()
+			return fmt.Errorf("timeout waiting for %d server ticks (observed %d within %v)", n, observed, timeout)
+		case <-a.ctx.Done():
+			return a.ctx.Err()
+		}
+	}
+}
+
 // GetPendingForm returns a pending form by ID
 func (a *Agent) GetPendingForm(id int32) (types.Form, bool) {
 	a.mu.RLock()
@@ -429,7 +1122,7 @@ func (a *Agent) GetLastForm() types.Form {
 // SubmitForm sends a form response to the server
 func (a *Agent) SubmitForm(formID int32, response types.FormResponse) error {
 	if !a.isConnected.Load() {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 
 	// Get the pending form to determine its type
@@ -0,0 +1,260 @@
+package agent
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unicode/utf8"
+
+	bestevents "github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+func TestEntityMove(t *testing.T) {
+	tests := []struct {
+		name string
+		move types.EntityMove
+		want types.Position
+	}{
+		{
+			name: "absolute",
+			move: types.EntityMove{RuntimeID: 7, Position: types.Position{X: 5, Y: 70, Z: -3}, HasX: true, HasY: true, HasZ: true},
+			want: types.Position{X: 5, Y: 70, Z: -3},
+		},
+		{
+			name: "delta keeps unsent coordinates",
+			move: types.EntityMove{RuntimeID: 7, Position: types.Position{X: 4}, HasX: true},
+			want: types.Position{X: 4, Y: 64, Z: 1},
+		},
+		{
+			name: "other entity",
+			move: types.EntityMove{RuntimeID: 8, Position: types.Position{X: 9, Y: 9, Z: 9}, HasX: true, HasY: true, HasZ: true},
+			want: types.Position{X: 1, Y: 64, Z: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAgent()
+			a.Emitter().Emit(bestevents.EventEntityAdd, &types.Entity{
+				RuntimeID: 7,
+				UniqueID:  70,
+				Type:      "minecraft:zombie",
+				Position:  types.Position{X: 1, Y: 64, Z: 1},
+			})
+			a.Emitter().Emit(bestevents.EventEntityMove, &tt.move)
+
+			entities := a.GetEntities()
+			if len(entities) != 1 {
+				t.Fatalf("GetEntities = %v, want one entity", entities)
+			}
+			if entities[0].Position != tt.want {
+				t.Errorf("Position = %v, want %v", entities[0].Position, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffInventory(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []types.InventoryItem
+		current  []types.InventoryItem
+		added    []types.InventoryItem
+		removed  []types.InventoryItem
+	}{
+		{
+			name:    "item gained",
+			current: []types.InventoryItem{{ID: "minecraft:diamond", Count: 5, Slot: 0}},
+			added:   []types.InventoryItem{{ID: "minecraft:diamond", Count: 5}},
+		},
+		{
+			name:     "item used up",
+			previous: []types.InventoryItem{{ID: "minecraft:bread", Count: 2, Slot: 3}},
+			removed:  []types.InventoryItem{{ID: "minecraft:bread", Count: 2}},
+		},
+		{
+			name:     "moved between slots",
+			previous: []types.InventoryItem{{ID: "minecraft:stone", Count: 64, Slot: 0}},
+			current:  []types.InventoryItem{{ID: "minecraft:stone", Count: 64, Slot: 8}},
+		},
+		{
+			name: "split stacks are totalled",
+			previous: []types.InventoryItem{
+				{ID: "minecraft:dirt", Count: 10, Slot: 0},
+				{ID: "minecraft:dirt", Count: 10, Slot: 1},
+			},
+			current: []types.InventoryItem{{ID: "minecraft:dirt", Count: 15, Slot: 0}},
+			removed: []types.InventoryItem{{ID: "minecraft:dirt", Count: 5}},
+		},
+		{
+			name:     "empty slots are ignored",
+			previous: []types.InventoryItem{{Slot: 0}},
+			current:  []types.InventoryItem{{Slot: 0}, {ID: "minecraft:apple", Count: 1, Slot: 1}},
+			added:    []types.InventoryItem{{ID: "minecraft:apple", Count: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := diffInventory(tt.previous, tt.current)
+			if !reflect.DeepEqual(change.Added, tt.added) {
+				t.Errorf("Added = %v, want %v", change.Added, tt.added)
+			}
+			if !reflect.DeepEqual(change.Removed, tt.removed) {
+				t.Errorf("Removed = %v, want %v", change.Removed, tt.removed)
+			}
+		})
+	}
+}
+
+// newAgentWithEntities returns an agent standing at pos that tracks the given entities
+func newAgentWithEntities(pos types.Position, entities ...types.Entity) *Agent {
+	a := NewAgent()
+	a.state.Position = pos
+	for i := range entities {
+		a.Emitter().Emit(bestevents.EventEntityAdd, &entities[i])
+	}
+	return a
+}
+
+func runtimeIDs(entities []types.Entity) []int64 {
+	ids := make([]int64, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.RuntimeID)
+	}
+	return ids
+}
+
+func TestGetNearbyEntities(t *testing.T) {
+	entities := []types.Entity{
+		{RuntimeID: 3, UniqueID: 30, Type: "minecraft:zombie", Position: types.Position{X: 10, Y: 64}},
+		{RuntimeID: 1, UniqueID: 10, Type: "minecraft:cow", Position: types.Position{X: 3, Y: 68}},
+		{RuntimeID: 2, UniqueID: 20, Type: "minecraft:pig", Position: types.Position{X: 10.5, Y: 64}},
+	}
+
+	tests := []struct {
+		name     string
+		entities []types.Entity
+		distance float64
+		want     []int64
+	}{
+		{"no entities", nil, 100, []int64{}},
+		{"none in range", entities, 4, []int64{}},
+		{"on the boundary", entities, 5, []int64{1}},
+		{"exactly at distance", entities, 10, []int64{1, 3}},
+		{"all in range", entities, 11, []int64{1, 2, 3}},
+		{"zero distance", entities, 0, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAgentWithEntities(types.Position{Y: 64}, tt.entities...)
+			if got := runtimeIDs(a.GetNearbyEntities(tt.distance)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNearbyEntities(%v) = %v, want %v", tt.distance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetEntitiesByType(t *testing.T) {
+	entities := []types.Entity{
+		{RuntimeID: 2, UniqueID: 20, Type: "minecraft:zombie"},
+		{RuntimeID: 1, UniqueID: 10, Type: "minecraft:zombie"},
+		{RuntimeID: 3, UniqueID: 30, Type: "minecraft:zombie_villager"},
+	}
+
+	tests := []struct {
+		name       string
+		entities   []types.Entity
+		entityType string
+		want       []int64
+	}{
+		{"no entities", nil, "minecraft:zombie", []int64{}},
+		{"exact type only", entities, "minecraft:zombie", []int64{1, 2}},
+		{"other type", entities, "minecraft:zombie_villager", []int64{3}},
+		{"no prefix", entities, "zombie", []int64{}},
+		{"unknown type", entities, "minecraft:creeper", []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAgentWithEntities(types.Position{}, tt.entities...)
+			if got := runtimeIDs(a.GetEntitiesByType(tt.entityType)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetEntitiesByType(%q) = %v, want %v", tt.entityType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNearestEntity(t *testing.T) {
+	entities := []types.Entity{
+		{RuntimeID: 4, UniqueID: 40, Type: "minecraft:cow", Position: types.Position{X: 1}},
+		{RuntimeID: 2, UniqueID: 20, Type: "minecraft:zombie", Position: types.Position{X: -3}},
+		{RuntimeID: 1, UniqueID: 10, Type: "minecraft:zombie", Position: types.Position{Z: 3}},
+		{RuntimeID: 3, UniqueID: 30, Type: "minecraft:zombie", Position: types.Position{X: 8}},
+	}
+
+	tests := []struct {
+		name       string
+		entities   []types.Entity
+		entityType string
+		want       int64 // 0 means no entity
+	}{
+		{"no entities", nil, "", 0},
+		{"any type", entities, "", 4},
+		{"tie broken by runtime ID", entities, "minecraft:zombie", 1},
+		{"unknown type", entities, "minecraft:creeper", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAgentWithEntities(types.Position{}, tt.entities...)
+			nearest := a.GetNearestEntity(tt.entityType)
+			switch {
+			case tt.want == 0 && nearest != nil:
+				t.Errorf("GetNearestEntity(%q) = %v, want nil", tt.entityType, nearest.RuntimeID)
+			case tt.want != 0 && nearest == nil:
+				t.Errorf("GetNearestEntity(%q) = nil, want %v", tt.entityType, tt.want)
+			case tt.want != 0 && nearest.RuntimeID != tt.want:
+				t.Errorf("GetNearestEntity(%q) = %v, want %v", tt.entityType, nearest.RuntimeID, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectRejectsUnknownMovementMode(t *testing.T) {
+	a := NewAgent(WithMovementMode("walk"))
+
+	if err := a.Connect(); !errors.Is(err, ErrUnknownMovementMode) {
+		t.Fatalf("Connect() error = %v, want ErrUnknownMovementMode", err)
+	}
+	if a.IsConnected() {
+		t.Error("agent should not be connected")
+	}
+}
+
+func TestRotatedUsername(t *testing.T) {
+	tests := []struct {
+		base    string
+		attempt int
+		want    string
+	}{
+		{"Bot", 1, "Bot_1"},
+		{"Bot", 12, "Bot_12"},
+		{"ExactlySixteenCh", 1, "ExactlySixteen_1"},
+		{"FifteenCharName", 10, "FifteenCharNa_10"},
+		{"テストボット", 1, "テストボット_1"},
+		{"あいうえおかきくけこさしすせそた", 1, "あいうえおかきくけこさしすせ_1"},
+	}
+
+	for _, tt := range tests {
+		got := rotatedUsername(tt.base, tt.attempt)
+		if got != tt.want {
+			t.Errorf("rotatedUsername(%q, %d) = %q, want %q", tt.base, tt.attempt, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("rotatedUsername(%q, %d) = %q is not valid UTF-8", tt.base, tt.attempt, got)
+		}
+	}
+}
@@ -4,7 +4,17 @@ import (
 	"github.com/gollilla/best/pkg/assertions"
 )
 
-// Expect returns an assertion context for this agent
+// Agent must satisfy the interface assertions are written against
+var _ assertions.AgentInterface = (*Agent)(nil)
+
+// Expect returns the assertion context for this agent
+// The context is created on first use and shared by later calls.
+//
+//	agent.Expect().Health().ToBeAbove(10)
+//	agent.Expect().Chat().ToReceive("Welcome", 5*time.Second, nil)
 func (a *Agent) Expect() *assertions.AssertionContext {
-	return assertions.NewAssertionContext(a)
+	a.expectOnce.Do(func() {
+		a.expect = assertions.NewAssertionContext(a)
+	})
+	return a.expect
 }
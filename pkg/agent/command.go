@@ -0,0 +1,91 @@
+package agent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CommandBuilder builds a command line with safely quoted arguments
+// Arguments added with Arg are quoted when they contain spaces or other characters the command
+// parser would split on, so player names and free text can be passed as-is.
+type CommandBuilder struct {
+	parts []string
+}
+
+// NewCommand starts a command; the leading slash is optional
+func NewCommand(name string) *CommandBuilder {
+	return &CommandBuilder{parts: []string{strings.TrimPrefix(name, "/")}}
+}
+
+// Arg appends arguments, quoting each one as needed (e.g. player names)
+func (b *CommandBuilder) Arg(args ...string) *CommandBuilder {
+	for _, arg := range args {
+		b.parts = append(b.parts, QuoteArg(arg))
+	}
+	return b
+}
+
+// Raw appends arguments without quoting (e.g. selectors such as @a[r=5] or JSON)
+func (b *CommandBuilder) Raw(args ...string) *CommandBuilder {
+	b.parts = append(b.parts, args...)
+	return b
+}
+
+// Int appends an integer argument
+func (b *CommandBuilder) Int(n int) *CommandBuilder {
+	b.parts = append(b.parts, strconv.Itoa(n))
+	return b
+}
+
+// Float appends a number argument with two decimals, as used for coordinates
+func (b *CommandBuilder) Float(f float64) *CommandBuilder {
+	b.parts = append(b.parts, fmt.Sprintf("%.2f", f))
+	return b
+}
+
+// Relative appends a relative coordinate (~offset)
+func (b *CommandBuilder) Relative(offset float64) *CommandBuilder {
+	b.parts = append(b.parts, fmt.Sprintf("~%.2f", offset))
+	return b
+}
+
+// Run appends another command, e.g. after "execute ... run"
+func (b *CommandBuilder) Run(cmd *CommandBuilder) *CommandBuilder {
+	b.parts = append(b.parts, cmd.parts...)
+	return b
+}
+
+// String returns the command line with a leading slash
+func (b *CommandBuilder) String() string {
+	return "/" + strings.Join(b.parts, " ")
+}
+
+// QuoteArg quotes a command argument if the command parser would not read it as a single word
+// Quoted arguments escape backslashes and double quotes. Empty strings become "".
+func QuoteArg(arg string) string {
+	if arg != "" && !strings.ContainsFunc(arg, needsQuoting) {
+		return arg
+	}
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range arg {
+		if r == '"' || r == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// needsQuoting reports whether a character cannot appear in an unquoted argument
+func needsQuoting(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	case strings.ContainsRune("_-.:+~^", r):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+package agent
+
+import (
+	"testing"
+)
+
+func TestQuoteArg(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want string
+	}{
+		{"Steve", "Steve"},
+		{"minecraft:diamond", "minecraft:diamond"},
+		{"~-1.5", "~-1.5"},
+		{"", `""`},
+		{"Two Words", `"Two Words"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`back\slash`, `"back\\slash"`},
+		{"名前", `"名前"`},
+	}
+
+	for _, tt := range tests {
+		if got := QuoteArg(tt.arg); got != tt.want {
+			t.Errorf("QuoteArg(%q) = %s, want %s", tt.arg, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package agent
+
+import (
+	"errors"
+
+	bestprotocol "github.com/gollilla/best/pkg/protocol"
+)
+
+// Errors returned by the agent, to be checked with errors.Is
+var (
+	// ErrNotConnected is returned by actions on an agent that is not connected
+	// It is the same error the protocol client returns for writes without a connection.
+	ErrNotConnected = bestprotocol.ErrNotConnected
+
+	// ErrAlreadyConnected is returned by Connect on an agent that is already connected
+	ErrAlreadyConnected = errors.New("already connected")
+
+	// ErrCommandTimeout is returned when no command response arrives within the command timeout
+	ErrCommandTimeout = errors.New("command timed out")
+
+	// ErrUnknownMovementMode is returned by Connect when the movement mode is not one of the MovementMode constants
+	ErrUnknownMovementMode = errors.New("unknown movement mode")
+)
@@ -0,0 +1,216 @@
+package agent
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+// SubmitCustomForm validates values against a pending custom form and submits them
+// values holds one value per element, in order: a string for inputs, a bool for toggles, a number
+// for sliders and an option index or option text for dropdowns and step sliders. Labels may be
+// given as nil or left out entirely.
+func (a *Agent) SubmitCustomForm(id int32, values []interface{}) error {
+	form, ok := a.GetPendingForm(id)
+	if !ok {
+		return fmt.Errorf("form with ID %d not found", id)
+	}
+	customForm, ok := form.(*types.CustomForm)
+	if !ok {
+		return fmt.Errorf("form %d is a %s form, not a custom form", id, form.GetType())
+	}
+
+	response, err := CustomFormResponse(customForm, values)
+	if err != nil {
+		return err
+	}
+	return a.SubmitForm(id, response)
+}
+
+// SubmitCustomFormByLabel submits a pending custom form with values keyed by element label text
+// Elements without a value keep their defaults, so fields can be reordered by the plugin without
+// breaking the caller. Values take the same forms as in SubmitCustomForm.
+func (a *Agent) SubmitCustomFormByLabel(id int32, values map[string]interface{}) error {
+	form, ok := a.GetPendingForm(id)
+	if !ok {
+		return fmt.Errorf("form with ID %d not found", id)
+	}
+	customForm, ok := form.(*types.CustomForm)
+	if !ok {
+		return fmt.Errorf("form %d is a %s form, not a custom form", id, form.GetType())
+	}
+
+	response, err := CustomFormResponseByLabel(customForm, values)
+	if err != nil {
+		return err
+	}
+	return a.SubmitForm(id, response)
+}
+
+// CustomFormResponseByLabel builds the response array for a custom form from values keyed by label text
+// Unspecified elements use their defaults. It fails if a label does not name an input element.
+func CustomFormResponseByLabel(form *types.CustomForm, values map[string]interface{}) ([]interface{}, error) {
+	positional := CustomFormDefaults(form)
+	for label, value := range values {
+		idx := slices.IndexFunc(form.Content, func(elem types.FormElement) bool {
+			_, isLabel := elem.(*types.Label)
+			return !isLabel && formElementLabel(elem) == label
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("custom form %q has no field %q", form.Title, label)
+		}
+		positional[idx] = value
+	}
+	return CustomFormResponse(form, positional)
+}
+
+// CustomFormResponse builds the response array for a custom form from values, as SubmitCustomForm does
+func CustomFormResponse(form *types.CustomForm, values []interface{}) ([]interface{}, error) {
+	inputs := 0
+	for _, elem := range form.Content {
+		if _, ok := elem.(*types.Label); !ok {
+			inputs++
+		}
+	}
+	skipLabels := len(values) != len(form.Content)
+	if skipLabels && len(values) != inputs {
+		return nil, fmt.Errorf("custom form %q expects %d values (or %d including labels), got %d",
+			form.Title, inputs, len(form.Content), len(values))
+	}
+
+	response := make([]interface{}, len(form.Content))
+	next := 0
+	for i, elem := range form.Content {
+		if _, ok := elem.(*types.Label); ok {
+			if !skipLabels {
+				next++
+			}
+			continue
+		}
+
+		value, err := customFormValue(elem, values[next])
+		if err != nil {
+			return nil, fmt.Errorf("element %d (%s): %w", i, elem.GetType(), err)
+		}
+		response[i] = value
+		next++
+	}
+	return response, nil
+}
+
+// CustomFormDefaults returns the default values of a custom form's elements, for use with SubmitCustomForm
+func CustomFormDefaults(form *types.CustomForm) []interface{} {
+	values := make([]interface{}, len(form.Content))
+	for i, elem := range form.Content {
+		switch e := elem.(type) {
+		case *types.Input:
+			values[i] = e.Default
+		case *types.Toggle:
+			values[i] = e.Default
+		case *types.Slider:
+			values[i] = e.Default
+		case *types.Dropdown:
+			values[i] = e.Default
+		case *types.StepSlider:
+			values[i] = e.Default
+		}
+	}
+	return values
+}
+
+// formElementLabel returns the label text of a custom form element
+func formElementLabel(elem types.FormElement) string {
+	switch e := elem.(type) {
+	case *types.Label:
+		return e.Text
+	case *types.Input:
+		return e.Text
+	case *types.Toggle:
+		return e.Text
+	case *types.Slider:
+		return e.Text
+	case *types.Dropdown:
+		return e.Text
+	case *types.StepSlider:
+		return e.Text
+	default:
+		return ""
+	}
+}
+
+// customFormValue checks a value against a custom form element and converts it to the response value
+func customFormValue(elem types.FormElement, value interface{}) (interface{}, error) {
+	switch e := elem.(type) {
+	case *types.Input:
+		text, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("input %q expects a string, got %T", e.Text, value)
+		}
+		return text, nil
+
+	case *types.Toggle:
+		on, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("toggle %q expects a bool, got %T", e.Text, value)
+		}
+		return on, nil
+
+	case *types.Slider:
+		n, ok := formNumber(value)
+		if !ok {
+			return nil, fmt.Errorf("slider %q expects a number, got %T", e.Text, value)
+		}
+		if n < e.Min || n > e.Max {
+			return nil, fmt.Errorf("slider %q expects a value in %v-%v, got %v", e.Text, e.Min, e.Max, n)
+		}
+		return n, nil
+
+	case *types.Dropdown:
+		return formOptionIndex("dropdown", e.Text, e.Options, value)
+
+	case *types.StepSlider:
+		return formOptionIndex("step slider", e.Text, e.Steps, value)
+
+	default:
+		return nil, fmt.Errorf("unsupported element type %q", elem.GetType())
+	}
+}
+
+// formOptionIndex resolves an option index or option text to an index into options
+func formOptionIndex(kind, label string, options []string, value interface{}) (int, error) {
+	if text, ok := value.(string); ok {
+		if idx := slices.Index(options, text); idx >= 0 {
+			return idx, nil
+		}
+		return 0, fmt.Errorf("%s %q has no option %q (options: %v)", kind, label, text, options)
+	}
+
+	n, ok := formNumber(value)
+	if !ok || n != math.Trunc(n) {
+		return 0, fmt.Errorf("%s %q expects an option index or text, got %v", kind, label, value)
+	}
+	if n < 0 || int(n) >= len(options) {
+		return 0, fmt.Errorf("%s %q has %d options, got index %d", kind, label, len(options), int(n))
+	}
+	return int(n), nil
+}
+
+// formNumber converts the numeric types found in Go code and decoded YAML/JSON to float64
+func formNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
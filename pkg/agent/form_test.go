@@ -0,0 +1,62 @@
+package agent
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+func TestCustomFormResponse(t *testing.T) {
+	form := &types.CustomForm{
+		Title: "Settings",
+		Content: []types.FormElement{
+			&types.Label{Text: "Welcome"},
+			&types.Input{Text: "Name"},
+			&types.Toggle{Text: "PvP"},
+			&types.Slider{Text: "Volume", Min: 0, Max: 10},
+			&types.Dropdown{Text: "Color", Options: []string{"red", "blue"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		values  []interface{}
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name:   "labels skipped",
+			values: []interface{}{"Steve", true, 5, "blue"},
+			want:   []interface{}{nil, "Steve", true, 5.0, 1},
+		},
+		{
+			name:   "labels included",
+			values: []interface{}{nil, "Steve", false, 2.5, 0},
+			want:   []interface{}{nil, "Steve", false, 2.5, 0},
+		},
+		{name: "wrong count", values: []interface{}{"Steve"}, wantErr: true},
+		{name: "wrong type", values: []interface{}{1, true, 5, 0}, wantErr: true},
+		{name: "slider out of range", values: []interface{}{"Steve", true, 11, 0}, wantErr: true},
+		{name: "unknown option", values: []interface{}{"Steve", true, 5, "green"}, wantErr: true},
+		{name: "option index out of range", values: []interface{}{"Steve", true, 5, 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CustomFormResponse(form, tt.values)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("CustomFormResponse = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CustomFormResponse: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CustomFormResponse = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package agent
+
+import (
+	"sync"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+// DefaultHistorySize is the number of chat messages and titles an agent remembers
+const DefaultHistorySize = 100
+
+// eventHistory is a ring buffer of the most recent events of one kind
+type eventHistory[T any] struct {
+	mu    sync.Mutex
+	items []T
+	next  int // index the next item is written to once the buffer is full
+	size  int
+}
+
+// newEventHistory creates a history keeping up to size items (at least 1)
+func newEventHistory[T any](size int) *eventHistory[T] {
+	return &eventHistory[T]{size: max(size, 1)}
+}
+
+// add records an item, overwriting the oldest one when the buffer is full
+func (h *eventHistory[T]) add(item T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.items) < h.size {
+		h.items = append(h.items, item)
+		return
+	}
+	h.items[h.next] = item
+	h.next = (h.next + 1) % h.size
+}
+
+// snapshot returns the recorded items, oldest first
+func (h *eventHistory[T]) snapshot() []T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	items := make([]T, 0, len(h.items))
+	items = append(items, h.items[h.next:]...)
+	return append(items, h.items[:h.next]...)
+}
+
+// clear forgets all recorded items
+func (h *eventHistory[T]) clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.items = nil
+	h.next = 0
+}
+
+// ChatHistory returns the most recent chat messages the agent received, oldest first
+// Messages are recorded from the moment the agent is created, so they can be checked after an
+// action without subscribing first. See WithHistorySize and ClearHistory.
+func (a *Agent) ChatHistory() []types.ChatMessage {
+	return a.chatHistory.snapshot()
+}
+
+// TitleHistory returns the most recent titles, subtitles and actionbar texts, oldest first
+func (a *Agent) TitleHistory() []types.TitleDisplay {
+	return a.titleHistory.snapshot()
+}
+
+// ClearHistory forgets the recorded chat messages and titles, e.g. at the start of a test
+// Disconnect and Reconnect clear the history as well.
+func (a *Agent) ClearHistory() {
+	a.chatHistory.clear()
+	a.titleHistory.clear()
+}
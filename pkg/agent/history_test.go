@@ -0,0 +1,39 @@
+package agent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEventHistory(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  int
+		added []int
+		want  []int
+	}{
+		{"empty", 3, nil, []int{}},
+		{"not full", 3, []int{1, 2}, []int{1, 2}},
+		{"full", 3, []int{1, 2, 3}, []int{1, 2, 3}},
+		{"wrapped", 3, []int{1, 2, 3, 4, 5}, []int{3, 4, 5}},
+		{"size below one keeps one", 0, []int{1, 2}, []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newEventHistory[int](tt.size)
+			for _, item := range tt.added {
+				h.add(item)
+			}
+			if got := h.snapshot(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("snapshot = %v, want %v", got, tt.want)
+			}
+
+			h.clear()
+			h.add(9)
+			if got := h.snapshot(); !reflect.DeepEqual(got, []int{9}) {
+				t.Errorf("snapshot after clear = %v, want [9]", got)
+			}
+		})
+	}
+}
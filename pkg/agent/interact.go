@@ -0,0 +1,216 @@
+package agent
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	bestevents "github.com/gollilla/best/pkg/events"
+	bestprotocol "github.com/gollilla/best/pkg/protocol"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// HotbarSize is the number of hotbar slots
+const HotbarSize = 9
+
+// SelectSlot selects a hotbar slot (0-8) to hold, like scrolling the hotbar
+// The server is told with a MobEquipment packet carrying the item in that slot. Servers do not
+// echo the selection back, so HeldSlot and HeldItem reflect it as soon as the packet is sent.
+func (a *Agent) SelectSlot(slot int32) error {
+	if slot < 0 || slot >= HotbarSize {
+		return fmt.Errorf("hotbar slot %d out of range (0-%d)", slot, HotbarSize-1)
+	}
+	if !a.isConnected.Load() {
+		return ErrNotConnected
+	}
+
+	if err := a.client.WritePacket(&packet.MobEquipment{
+		EntityRuntimeID: uint64(a.State().RuntimeEntityID),
+		NewItem:         a.itemInstance(slot),
+		InventorySlot:   byte(slot),
+		HotBarSlot:      byte(slot),
+		WindowID:        protocol.WindowIDInventory,
+	}); err != nil {
+		return err
+	}
+
+	a.emitter.Emit(bestevents.EventHeldSlotUpdate, slot)
+	return nil
+}
+
+// itemInSlot returns the inventory item in slot, or nil if the slot is empty
+func (a *Agent) itemInSlot(slot int32) *types.InventoryItem {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, item := range a.inventory {
+		if item.Slot == slot && item.ID != "" {
+			return &item
+		}
+	}
+	return nil
+}
+
+// itemInstance returns the network item in slot, or an empty item if the slot is empty
+func (a *Agent) itemInstance(slot int32) protocol.ItemInstance {
+	var stack protocol.ItemStack
+	if item := a.itemInSlot(slot); item != nil {
+		stack.NetworkID = bestprotocol.GetNetworkID(item.ID)
+		stack.Count = uint16(item.Count)
+	}
+	return protocol.ItemInstance{Stack: stack}
+}
+
+// BreakBlock breaks the block at pos
+// In auth_input movement mode the break is reported through PlayerAuthInput block actions and
+// item interaction data, as client-authoritative servers expect. Otherwise PlayerAction packets
+// and a legacy break-block InventoryTransaction are sent.
+func (a *Agent) BreakBlock(pos types.Position) error {
+	if !a.isConnected.Load() {
+		return ErrNotConnected
+	}
+
+	blockPos := toBlockPos(pos)
+	data := a.useItemData(protocol.UseItemActionBreakBlock, blockPos, types.BlockFaceUp)
+
+	if a.movementMode == MovementModeAuthInput {
+		return a.sendAuthInputInteraction(func(pk *packet.PlayerAuthInput) {
+			pk.InputData.Set(packet.InputFlagPerformBlockActions)
+			pk.BlockActions = []protocol.PlayerBlockAction{
+				{Action: protocol.PlayerActionStartBreak, BlockPos: blockPos, Face: int32(types.BlockFaceUp)},
+				{Action: protocol.PlayerActionPredictDestroyBlock, BlockPos: blockPos, Face: int32(types.BlockFaceUp)},
+			}
+			pk.InputData.Set(packet.InputFlagPerformItemInteraction)
+			pk.ItemInteractionData = *data
+		})
+	}
+
+	entityID := uint64(a.State().RuntimeEntityID)
+	if err := a.client.WritePacket(&packet.PlayerAction{
+		EntityRuntimeID: entityID,
+		ActionType:      protocol.PlayerActionStartBreak,
+		BlockPosition:   blockPos,
+		BlockFace:       int32(types.BlockFaceUp),
+	}); err != nil {
+		return err
+	}
+	if err := a.client.WriteTransaction(data); err != nil {
+		return err
+	}
+	return a.client.WritePacket(&packet.PlayerAction{
+		EntityRuntimeID: entityID,
+		ActionType:      protocol.PlayerActionStopBreak,
+		BlockPosition:   blockPos,
+		BlockFace:       int32(types.BlockFaceUp),
+	})
+}
+
+// UseItem uses the held item on the given face of the block at pos, like a right click
+// A block placed this way ends up at pos.Offset(face).
+// In auth_input movement mode the click is sent as PlayerAuthInput item interaction data;
+// otherwise a legacy use-item InventoryTransaction is sent.
+func (a *Agent) UseItem(pos types.Position, face types.BlockFace) error {
+	if !a.isConnected.Load() {
+		return ErrNotConnected
+	}
+
+	data := a.useItemData(protocol.UseItemActionClickBlock, toBlockPos(pos), face)
+
+	if a.movementMode == MovementModeAuthInput {
+		return a.sendAuthInputInteraction(func(pk *packet.PlayerAuthInput) {
+			pk.InputData.Set(packet.InputFlagPerformItemInteraction)
+			pk.ItemInteractionData = *data
+		})
+	}
+
+	return a.client.WriteTransaction(data)
+}
+
+// PlaceBlock places the held block at pos
+// The block is placed by clicking the given face of the neighbouring block it attaches to, i.e. the
+// block at pos.Offset(face.Opposite()); BlockFaceUp places it on top of the block below pos. That
+// neighbour must be solid for the server to accept the placement.
+func (a *Agent) PlaceBlock(pos types.Position, face types.BlockFace) error {
+	return a.UseItem(pos.Offset(face.Opposite()), face)
+}
+
+// AttackEntity attacks an entity by the runtime ID reported by GetEntities
+// Pick the target with e.g. GetNearestEntity("minecraft:zombie") and check the hit with
+// Expect().Sound().ToPlayNear("hurt", entity.Position, radius, timeout) or
+// Expect().Packet().ToReceivePacket(packet.IDActorEvent, 1, timeout).
+// Entity attacks have no PlayerAuthInput equivalent, so a UseItemOnEntity transaction is sent
+// in every movement mode; in auth_input mode it follows an input tick so the attack is
+// processed against the agent's current client-authoritative position.
+func (a *Agent) AttackEntity(runtimeID int64) error {
+	return a.useItemOnEntity(func(playerPos mgl32.Vec3) protocol.InventoryTransactionData {
+		return bestprotocol.BuildAttackTransaction(uint64(runtimeID), playerPos)
+	})
+}
+
+// InteractEntity interacts with (right clicks) an entity by the runtime ID reported by GetEntities
+// The held item is used on the entity, e.g. to trade with a villager or feed an animal.
+func (a *Agent) InteractEntity(runtimeID int64) error {
+	return a.useItemOnEntity(func(playerPos mgl32.Vec3) protocol.InventoryTransactionData {
+		return bestprotocol.BuildInteractTransaction(uint64(runtimeID), playerPos, mgl32.Vec3{})
+	})
+}
+
+// useItemOnEntity swings the arm and sends the UseItemOnEntity transaction built by build
+func (a *Agent) useItemOnEntity(build func(playerPos mgl32.Vec3) protocol.InventoryTransactionData) error {
+	if !a.isConnected.Load() {
+		return ErrNotConnected
+	}
+
+	state := a.State()
+	if a.movementMode == MovementModeAuthInput {
+		if err := a.sendAuthInputInteraction(func(*packet.PlayerAuthInput) {}); err != nil {
+			return err
+		}
+	}
+
+	if err := a.client.WritePacket(&packet.Animate{
+		ActionType:      packet.AnimateActionSwingArm,
+		EntityRuntimeID: uint64(state.RuntimeEntityID),
+	}); err != nil {
+		return err
+	}
+
+	data := build(toVec3(state.Position))
+	if entityData, ok := data.(*protocol.UseItemOnEntityTransactionData); ok {
+		slot := a.HeldSlot()
+		entityData.HotBarSlot = slot
+		entityData.HeldItem = a.itemInstance(slot)
+	}
+	return a.client.WriteTransaction(data)
+}
+
+// useItemData builds the use-item transaction data shared by the legacy and auth-input paths
+func (a *Agent) useItemData(action uint32, blockPos protocol.BlockPos, face types.BlockFace) *protocol.UseItemTransactionData {
+	slot := a.HeldSlot()
+	return bestprotocol.BuildUseItemTransaction(action, blockPos, int32(face), toVec3(a.Position()), slot, a.itemInstance(slot))
+}
+
+// sendAuthInputInteraction sends a PlayerAuthInput at the current position and rotation after
+// applying modify, which sets the interaction flags and data
+func (a *Agent) sendAuthInputInteraction(modify func(pk *packet.PlayerAuthInput)) error {
+	state := a.State()
+	pk := a.buildAuthInput(state.Position, state.Rotation.Yaw, state.Rotation.Pitch)
+	modify(pk)
+	return a.client.WritePacket(pk)
+}
+
+// toBlockPos converts a position to the block position containing it
+func toBlockPos(pos types.Position) protocol.BlockPos {
+	return protocol.BlockPos{
+		int32(math.Floor(pos.X)),
+		int32(math.Floor(pos.Y)),
+		int32(math.Floor(pos.Z)),
+	}
+}
+
+// toVec3 converts a position to a protocol vector
+func toVec3(pos types.Position) mgl32.Vec3 {
+	return mgl32.Vec3{float32(pos.X), float32(pos.Y), float32(pos.Z)}
+}
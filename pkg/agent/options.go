@@ -38,6 +38,33 @@ func WithTimeout(timeout time.Duration) AgentOption {
 	}
 }
 
+// WithSpawnTimeout sets how long Connect waits for the server to complete the spawn sequence
+// A server that never finishes spawning makes Connect fail with a "spawn timed out" error
+// instead of hanging.
+func WithSpawnTimeout(timeout time.Duration) AgentOption {
+	return func(a *Agent) {
+		a.options.SpawnTimeout = timeout
+	}
+}
+
+// WithWaitForAttributes makes Connect wait up to timeout for the first health/hunger attribute update
+// Connect fails if none arrives in time, so state assertions never see the zero values from before
+// the server's first sync (0 disables the wait, the default).
+func WithWaitForAttributes(timeout time.Duration) AgentOption {
+	return func(a *Agent) {
+		a.attributesTimeout = timeout
+	}
+}
+
+// WithResourcePacks sets whether resource packs offered by the server during login are downloaded
+// Declining speeds up connecting, but servers that force their packs will refuse the agent.
+// Packs are accepted by default.
+func WithResourcePacks(accept bool) AgentOption {
+	return func(a *Agent) {
+		a.options.DeclineResourcePacks = !accept
+	}
+}
+
 // WithVersion sets the Minecraft version
 func WithVersion(version string) AgentOption {
 	return func(a *Agent) {
@@ -53,7 +80,85 @@ func WithXUID(xuid string) AgentOption {
 	}
 }
 
-// WithCommandPrefix sets the command prefix for agent mode
+// WithIdentityRotation sets how many times Connect retries with a fresh identity
+// when the server rejects the login with "Logged in from other location"
+// Each retry uses a new XUID and UUID and keeps the display name (see WithIdentityRotationRename)
+// This makes rapid reconnects reliable on servers with strict single-session enforcement
+func WithIdentityRotation(maxRotations int) AgentOption {
+	return func(a *Agent) {
+		a.maxIdentityRotations = maxRotations
+	}
+}
+
+// DefaultIdentityRotationBackoff is the default wait before each identity rotation retry
+const DefaultIdentityRotationBackoff = time.Second
+
+// maxUsernameLength is the longest display name Bedrock servers accept
+const maxUsernameLength = 16
+
+// WithIdentityRotationBackoff sets how long Connect waits before each identity rotation retry
+func WithIdentityRotationBackoff(backoff time.Duration) AgentOption {
+	return func(a *Agent) {
+		a.identityRotationBackoff = backoff
+	}
+}
+
+// WithIdentityRotationRename also gives each identity rotation retry a numbered display name
+// (e.g. "Bot_1"), which Username reports once connected
+// Only needed on servers that also refuse a second login under the same name.
+func WithIdentityRotationRename(rename bool) AgentOption {
+	return func(a *Agent) {
+		a.identityRotationRename = rename
+	}
+}
+
+// WithKeepAlive sends a no-op movement packet whenever the agent has not moved for interval
+// Use this to avoid idle kicks during long waits. It is disabled by default (0 disables it),
+// since even a no-op movement is visible to anti-cheat and idle plugins.
+func WithKeepAlive(interval time.Duration) AgentOption {
+	return func(a *Agent) {
+		a.keepAliveInterval = interval
+	}
+}
+
+// DefaultMaxEntities is the default number of entities an agent tracks
+const DefaultMaxEntities = 1024
+
+// WithMaxEntities limits how many entities the agent tracks (0 = unlimited)
+// When the limit is exceeded, the entities farthest from the agent are dropped first.
+func WithMaxEntities(max int) AgentOption {
+	return func(a *Agent) {
+		a.maxEntities = max
+	}
+}
+
+// WithEventBufferSize sets how many events each asynchronous listener buffers (default 100)
+// Events are dropped for a listener whose buffer is full, which can make waiting assertions
+// time out on busy servers; raise this when chunk streaming or many entities flood events.
+func WithEventBufferSize(n int) AgentOption {
+	return func(a *Agent) {
+		a.eventBufferSize = n
+	}
+}
+
+// WithHistorySize sets how many chat messages and titles the agent remembers for ToHaveSeen (default 100)
+func WithHistorySize(n int) AgentOption {
+	return func(a *Agent) {
+		a.historySize = n
+	}
+}
+
+// WithSessionRecorder records every packet received from the server to the file at path
+// The recording can be replayed offline with besttest.ReplayAgent. Reconnects append to the same file.
+func WithSessionRecorder(path string) AgentOption {
+	return func(a *Agent) {
+		a.options.SessionRecordPath = path
+	}
+}
+
+// WithCommandPrefix sets the prefix Command prepends to commands without a leading slash
+// Use this for servers whose plugin commands are typed in chat with another prefix (e.g. "!").
+// Commands starting with "/" are always sent as slash commands.
 func WithCommandPrefix(prefix string) AgentOption {
 	return func(a *Agent) {
 		a.commandPrefix = prefix
@@ -67,13 +172,42 @@ func WithCommandSendMethod(method string) AgentOption {
 	}
 }
 
-// WithCommandTimeout sets the command timeout for assertions
+// WithMovementMode sets how movement, rotation and block interactions are sent
+// Use MovementModeAuthInput for servers with client-authoritative movement that ignore MovePlayer and legacy transactions.
+// Connect returns ErrUnknownMovementMode for any other value than the MovementMode constants.
+func WithMovementMode(mode MovementMode) AgentOption {
+	return func(a *Agent) {
+		a.movementMode = mode
+	}
+}
+
+// WithCommandTimeout sets how long CommandWithResponse and command assertions wait for a response
 func WithCommandTimeout(timeout time.Duration) AgentOption {
 	return func(a *Agent) {
 		a.commandTimeout = timeout
 	}
 }
 
+// WithMaxCommandOutputLines caps the lines kept in CommandWithResponse output (0 = unlimited)
+// Further lines are replaced by a "... (N more lines)" marker, which keeps failure messages for
+// verbose commands such as /help readable.
+func WithMaxCommandOutputLines(n int) AgentOption {
+	return func(a *Agent) {
+		a.maxOutputLines = n
+	}
+}
+
+// WithMacros sets the named command sequences available to RunMacro
+// Commands may contain positional placeholders $1, $2, ... filled from RunMacro's arguments.
+func WithMacros(macros map[string][]string) AgentOption {
+	return func(a *Agent) {
+		a.macros = make(map[string][]string, len(macros))
+		for name, commands := range macros {
+			a.macros[name] = append([]string(nil), commands...)
+		}
+	}
+}
+
 // DefaultOptions returns default client options
 func DefaultOptions() types.ClientOptions {
 	return types.ClientOptions{
@@ -82,5 +216,7 @@ func DefaultOptions() types.ClientOptions {
 		Username: "TestBot",
 		Timeout:  30 * time.Second,
 		Version:  "1.21.130",
+
+		SpawnTimeout: 30 * time.Second,
 	}
 }
@@ -0,0 +1,67 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ReconnectOptions controls how Reconnect retries the connection
+// Zero fields use the defaults below.
+type ReconnectOptions struct {
+	MaxAttempts    int           // connection attempts (default 3)
+	InitialBackoff time.Duration // wait after the first failed attempt (default 1s)
+	MaxBackoff     time.Duration // cap for the doubling wait between attempts (default 10s)
+}
+
+// Default reconnect settings
+const (
+	DefaultReconnectAttempts = 3
+	DefaultReconnectBackoff  = time.Second
+	DefaultReconnectMaxWait  = 10 * time.Second
+)
+
+// Reconnect disconnects (if connected) and connects again, retrying with exponential backoff
+// Server-side state such as scoreboards is re-sent on the new connection, so it can be asserted
+// afterwards to check that it survived the reconnect.
+func (a *Agent) Reconnect(opts ReconnectOptions) error {
+	return a.ReconnectContext(context.Background(), opts)
+}
+
+// ReconnectContext reconnects like Reconnect, giving up when ctx is done
+func (a *Agent) ReconnectContext(ctx context.Context, opts ReconnectOptions) error {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultReconnectAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = DefaultReconnectBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = DefaultReconnectMaxWait
+	}
+
+	if err := a.Disconnect(); err != nil {
+		return fmt.Errorf("reconnect: disconnect failed: %w", err)
+	}
+	// Disconnect is a no-op when the server already dropped the connection
+	a.ClearHistory()
+
+	backoff := opts.InitialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= opts.MaxAttempts; attempt++ {
+		if lastErr = a.ConnectContext(ctx); lastErr == nil {
+			return nil
+		}
+		if attempt == opts.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("reconnect cancelled after %d attempt(s): %w", attempt, lastErr)
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, opts.MaxBackoff)
+	}
+	return fmt.Errorf("reconnect failed after %d attempt(s): %w", opts.MaxAttempts, lastErr)
+}
@@ -10,6 +10,7 @@ import (
 type AgentInterface interface {
 	// Connection
 	IsConnected() bool
+	IsSpawned() bool
 
 	// State accessors
 	Position() types.Position
@@ -19,11 +20,19 @@ type AgentInterface interface {
 
 	// Collections
 	GetInventory() []types.InventoryItem
+	HeldItem() *types.InventoryItem
 	GetEffects() []types.Effect
 	GetEntities() []types.Entity
 	GetTags() []string
 	GetHunger() float32
+	GetDeathCount() int
 	GetPermissionLevel() int32
+	GetPlayerList() []types.PlayerListEntry
+	GetBossBars() []types.BossBar
+	DisplayName() string
+
+	// World
+	GetBlockAt(pos types.Position) (*types.Block, bool)
 
 	// Scoreboard
 	GetScore(objectiveName string) *int32
@@ -37,6 +46,13 @@ type AgentInterface interface {
 	SubmitForm(formID int32, response types.FormResponse) error
 	ClearPendingForms()
 
+	// History of recent events, oldest first
+	ChatHistory() []types.ChatMessage
+	TitleHistory() []types.TitleDisplay
+
+	// Raw packets
+	PacketCount(packetID uint32) int
+
 	// Event system
 	Emitter() *events.Emitter
 }
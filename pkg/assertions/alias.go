@@ -0,0 +1,131 @@
+package assertions
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultAliases is the curated table of informal item and block names used once aliases are enabled
+// Each alias stands for any of the listed IDs, e.g. "wood" matches every kind of planks.
+// Items whose variants share one ID and differ in metadata (e.g. minecraft:bed) need no alias.
+var DefaultAliases = map[string][]string{
+	"wood":    woodVariants("planks"),
+	"planks":  woodVariants("planks"),
+	"log":     woodVariants("log"),
+	"wool":    colorVariants("wool"),
+	"glass":   append([]string{"minecraft:glass"}, colorVariants("stained_glass")...),
+	"sword":   toolVariants("sword"),
+	"pickaxe": toolVariants("pickaxe"),
+	"axe":     toolVariants("axe"),
+	"shovel":  toolVariants("shovel"),
+	"hoe":     toolVariants("hoe"),
+	"steak":   {"minecraft:cooked_beef"},
+	"pearl":   {"minecraft:ender_pearl"},
+}
+
+var (
+	aliasesEnabled atomic.Bool
+	aliasesMu      sync.RWMutex
+	aliases        = cloneAliases(DefaultAliases)
+)
+
+// EnableAliases turns alias matching on or off for item and block assertions (off by default)
+// While enabled, an expected ID that is a known alias also matches the IDs it stands for in
+// FuzzyItemMatcher and block assertions; ExactItemMatcher never applies aliases.
+func EnableAliases(enabled bool) {
+	aliasesEnabled.Store(enabled)
+}
+
+// AliasesEnabled reports whether alias matching is on
+func AliasesEnabled() bool {
+	return aliasesEnabled.Load()
+}
+
+// SetAlias adds an alias or replaces an existing one, including the defaults
+// IDs without a namespace get "minecraft". It does not enable alias matching by itself.
+func SetAlias(alias string, ids ...string) {
+	normalized := make([]string, len(ids))
+	for i, id := range ids {
+		normalized[i] = NormalizeItemID(id)
+	}
+
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+	aliases[strings.ToLower(alias)] = normalized
+}
+
+// RemoveAlias removes an alias
+func RemoveAlias(alias string) {
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+	delete(aliases, strings.ToLower(alias))
+}
+
+// ResetAliases restores DefaultAliases, dropping aliases added with SetAlias
+func ResetAliases() {
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+	aliases = cloneAliases(DefaultAliases)
+}
+
+// ResolveAlias returns the IDs an alias stands for, or nil if alias matching is off or alias is unknown
+func ResolveAlias(alias string) []string {
+	if !aliasesEnabled.Load() {
+		return nil
+	}
+
+	aliasesMu.RLock()
+	defer aliasesMu.RUnlock()
+	return slices.Clone(aliases[strings.ToLower(strings.TrimSpace(alias))])
+}
+
+// matchesAlias reports whether actualID is one of the IDs expected stands for as an alias
+func matchesAlias(actualID, expected string) bool {
+	ids := ResolveAlias(expected)
+	return len(ids) > 0 && slices.Contains(ids, NormalizeItemID(actualID))
+}
+
+// cloneAliases copies an alias table so DefaultAliases is never modified
+func cloneAliases(table map[string][]string) map[string][]string {
+	clone := maps.Clone(table)
+	for alias, ids := range clone {
+		clone[alias] = slices.Clone(ids)
+	}
+	return clone
+}
+
+// woodVariants returns the IDs of kind (e.g. "planks") for every overworld wood type
+func woodVariants(kind string) []string {
+	woods := []string{"oak", "spruce", "birch", "jungle", "acacia", "dark_oak", "mangrove", "cherry"}
+	ids := make([]string, len(woods))
+	for i, wood := range woods {
+		ids[i] = "minecraft:" + wood + "_" + kind
+	}
+	return ids
+}
+
+// colorVariants returns the IDs of kind (e.g. "wool") in every dye color
+func colorVariants(kind string) []string {
+	colors := []string{
+		"white", "orange", "magenta", "light_blue", "yellow", "lime", "pink", "gray",
+		"light_gray", "cyan", "purple", "blue", "brown", "green", "red", "black",
+	}
+	ids := make([]string, len(colors))
+	for i, color := range colors {
+		ids[i] = "minecraft:" + color + "_" + kind
+	}
+	return ids
+}
+
+// toolVariants returns the IDs of tool (e.g. "sword") in every material
+func toolVariants(tool string) []string {
+	materials := []string{"wooden", "stone", "iron", "golden", "diamond", "netherite"}
+	ids := make([]string, len(materials))
+	for i, material := range materials {
+		ids[i] = "minecraft:" + material + "_" + tool
+	}
+	return ids
+}
@@ -0,0 +1,46 @@
+package assertions
+
+import "testing"
+
+func TestItemMatcherAliases(t *testing.T) {
+	EnableAliases(true)
+	SetAlias("gem", "diamond", "minecraft:emerald")
+	t.Cleanup(func() {
+		EnableAliases(false)
+		ResetAliases()
+	})
+
+	tests := []struct {
+		actual   string
+		expected string
+		fuzzy    bool
+		exact    bool
+	}{
+		{"minecraft:spruce_planks", "wood", true, false},
+		{"minecraft:emerald", "gem", true, false},
+		{"minecraft:diamond", "gem", true, false},
+		{"minecraft:diamond", "minecraft:diamond", true, true},
+		{"minecraft:diamond", "DIAMOND", false, false},
+		{"minecraft:diamond", "diamond", true, true},
+		{"minecraft:stone", "wood", false, false},
+		{"minecraft:bed", "bed", true, true},
+	}
+
+	for _, tt := range tests {
+		if got := FuzzyItemMatcher(tt.actual, tt.expected); got != tt.fuzzy {
+			t.Errorf("FuzzyItemMatcher(%q, %q) = %v, want %v", tt.actual, tt.expected, got, tt.fuzzy)
+		}
+		if got := ExactItemMatcher(tt.actual, tt.expected); got != tt.exact {
+			t.Errorf("ExactItemMatcher(%q, %q) = %v, want %v", tt.actual, tt.expected, got, tt.exact)
+		}
+	}
+}
+
+func TestAliasesDisabledByDefault(t *testing.T) {
+	if AliasesEnabled() {
+		t.Fatal("aliases are enabled before EnableAliases is called")
+	}
+	if FuzzyItemMatcher("minecraft:spruce_planks", "wood") {
+		t.Error(`"wood" matched minecraft:spruce_planks with aliases disabled`)
+	}
+}
@@ -0,0 +1,110 @@
+package assertions
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// BlockAssertion provides assertions on the block at a position
+// Block names come from the world's block registry, which is filled from the server's block palette on
+// connect. Assertions fail with an explicit error for runtime IDs missing from it.
+type BlockAssertion struct {
+	agent AgentInterface
+	pos   types.Position
+}
+
+// ToBe checks that the block at the position has the given name (e.g. "minecraft:chest" or "chest")
+func (b *BlockAssertion) ToBe(blockName string) {
+	block, ok := b.agent.GetBlockAt(b.pos)
+	if !ok {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected %s at %s, but the chunk is not loaded", NormalizeItemID(blockName), blockPosition(b.pos)),
+			NormalizeItemID(blockName),
+			nil,
+		))
+	}
+	if block.Name == "" {
+		panic(b.unknownBlockError(block, blockName))
+	}
+	if !b.matches(block, blockName) {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected %s at %s", NormalizeItemID(blockName), blockPosition(b.pos)),
+			NormalizeItemID(blockName),
+			blockDescription(block),
+		))
+	}
+}
+
+// ToBeAir checks that the block at the position is air
+func (b *BlockAssertion) ToBeAir() {
+	b.ToBe("minecraft:air")
+}
+
+// ToBecome waits until the block at the position has the given name
+// It passes immediately if the block already has that name, and re-checks on block updates and
+// chunk loads.
+func (b *BlockAssertion) ToBecome(blockName string, timeout time.Duration) {
+	matched := waitUntil(b.agent.Emitter(),
+		[]events.EventName{events.EventBlockUpdate, events.EventChunkLoaded, events.EventSubChunkLoaded},
+		func() bool {
+			block, ok := b.agent.GetBlockAt(b.pos)
+			return ok && b.matches(block, blockName)
+		}, timeout)
+
+	if !matched {
+		block, _ := b.agent.GetBlockAt(b.pos)
+		if block != nil && block.Name == "" {
+			panic(b.unknownBlockError(block, blockName))
+		}
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for %s at %s", NormalizeItemID(blockName), blockPosition(b.pos)),
+			NormalizeItemID(blockName),
+			blockDescription(block),
+		))
+	}
+}
+
+// matches compares a block's name with the expected name or, once enabled, the IDs it is an alias of
+func (b *BlockAssertion) matches(block *types.Block, blockName string) bool {
+	if block.Name == "" {
+		return false
+	}
+	return NormalizeItemID(block.Name) == NormalizeItemID(blockName) || matchesAlias(block.Name, blockName)
+}
+
+// unknownBlockError reports a block whose runtime ID is not in the block registry
+func (b *BlockAssertion) unknownBlockError(block *types.Block, blockName string) *AssertionError {
+	return NewAssertionError(
+		fmt.Sprintf("cannot check for %s at %s: runtime ID %d is not in the block registry (the server's block palette does not name it)",
+			NormalizeItemID(blockName), blockPosition(b.pos), block.RuntimeID),
+		NormalizeItemID(blockName),
+		blockDescription(block),
+	)
+}
+
+// sameBlockPosition reports whether two positions are within the same block
+func sameBlockPosition(a, b types.Position) bool {
+	return math.Floor(a.X) == math.Floor(b.X) &&
+		math.Floor(a.Y) == math.Floor(b.Y) &&
+		math.Floor(a.Z) == math.Floor(b.Z)
+}
+
+// blockPosition formats the block coordinates containing pos
+func blockPosition(pos types.Position) string {
+	return fmt.Sprintf("(%.0f, %.0f, %.0f)", math.Floor(pos.X), math.Floor(pos.Y), math.Floor(pos.Z))
+}
+
+// blockDescription describes a block for assertion errors
+func blockDescription(block *types.Block) string {
+	if block == nil {
+		return "chunk not loaded"
+	}
+	if block.Name == "" {
+		return fmt.Sprintf("unknown block (runtime ID %d)", block.RuntimeID)
+	}
+	return block.Name
+}
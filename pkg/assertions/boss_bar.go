@@ -0,0 +1,72 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// BossBarAssertion provides assertions on the boss bars shown to the agent
+type BossBarAssertion struct {
+	agent AgentInterface
+}
+
+// ToShow waits for a boss bar whose title matches titlePattern within the timeout and returns it
+// titlePattern is a substring or a *regexp.Regexp. A bar that is already shown passes immediately.
+func (b *BossBarAssertion) ToShow(titlePattern interface{}, timeout time.Duration) *types.BossBar {
+	var found *types.BossBar
+	check := func() bool {
+		for _, bar := range b.agent.GetBossBars() {
+			if matchesPattern(bar.Title, titlePattern) {
+				found = &bar
+				return true
+			}
+		}
+		return false
+	}
+
+	if !waitUntil(b.agent.Emitter(), []events.EventName{events.EventBossBar}, check, timeout) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for boss bar matching %v within %v", titlePattern, timeout),
+			titlePattern,
+			bossBarTitles(b.agent.GetBossBars()),
+		))
+	}
+	return found
+}
+
+// ToHavePercentageAbove checks that a shown boss bar is filled above min (0.0-1.0)
+func (b *BossBarAssertion) ToHavePercentageAbove(min float32) {
+	above := func(bars []types.BossBar) bool {
+		for _, bar := range bars {
+			if bar.Percentage > min {
+				return true
+			}
+		}
+		return false
+	}
+	bars := pollState(b.agent.GetBossBars, above)
+
+	if !above(bars) {
+		percentages := make([]float32, len(bars))
+		for i, bar := range bars {
+			percentages[i] = bar.Percentage
+		}
+		panic(NewAssertionError(
+			fmt.Sprintf("expected a boss bar with percentage above %.2f", min),
+			fmt.Sprintf("> %.2f", min),
+			percentages,
+		))
+	}
+}
+
+// bossBarTitles returns the titles of the given boss bars
+func bossBarTitles(bars []types.BossBar) []string {
+	titles := make([]string, len(bars))
+	for i, bar := range bars {
+		titles[i] = bar.Title
+	}
+	return titles
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,13 +28,7 @@ func (c *ChatAssertion) ToReceive(expected interface{}, timeout time.Duration, o
 
 	filter := func(data events.EventData) bool {
 		msg, ok := data.(*types.ChatMessage)
-		if !ok {
-			return false
-		}
-		if options.From != "" && msg.Sender != options.From {
-			return false
-		}
-		return matchesPattern(msg.Message, expected)
+		return ok && options.matches(msg, expected)
 	}
 
 	data, err := c.agent.Emitter().WaitFor(ctx, events.EventChat, filter)
@@ -42,6 +37,9 @@ func (c *ChatAssertion) ToReceive(expected interface{}, timeout time.Duration, o
 		if options.From != "" {
 			fromStr = fmt.Sprintf(" from %s", options.From)
 		}
+		if options.DirectedOnly {
+			fromStr += " directed at this agent"
+		}
 		panic(NewAssertionError(
 			fmt.Sprintf("Timeout waiting for chat message matching %v%s", expected, fromStr),
 			expected,
@@ -80,6 +78,45 @@ func (c *ChatAssertion) ToReceiveSystem(expected interface{}, timeout time.Durat
 	return data.(*types.ChatMessage)
 }
 
+// ToReceiveTranslation waits for a translated message with the given key, such as
+// "multiplayer.player.joined", regardless of the server language
+// args are compared with the translation parameters by position; pass nil to ignore them.
+func (c *ChatAssertion) ToReceiveTranslation(key string, args []string, timeout time.Duration) *types.ChatMessage {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	key = strings.TrimPrefix(key, "%")
+
+	filter := func(data events.EventData) bool {
+		msg, ok := data.(*types.ChatMessage)
+		if !ok {
+			return false
+		}
+		if msg.TranslationKey != key {
+			return false
+		}
+		if args == nil {
+			return true
+		}
+		return slices.Equal(msg.Parameters, args)
+	}
+
+	data, err := c.agent.Emitter().WaitFor(ctx, events.EventChat, filter)
+	if err != nil {
+		argsStr := ""
+		if args != nil {
+			argsStr = fmt.Sprintf(" with args %v", args)
+		}
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for translated message %q%s", key, argsStr),
+			key,
+			nil,
+		))
+	}
+
+	return data.(*types.ChatMessage)
+}
+
 // NotToReceive asserts that no matching message is received within duration
 func (c *ChatAssertion) NotToReceive(ctx context.Context, pattern interface{}, duration time.Duration) {
 	if duration == 0 {
@@ -164,9 +201,78 @@ func (c *ChatAssertion) ToReceiveInOrder(ctx context.Context, expected []interfa
 	return received
 }
 
+// ToHaveSeen checks that a chat message matching pattern is in the agent's chat history and returns it
+// Unlike ToReceive it needs no subscription before the action, so it can follow a command directly.
+// Messages that have not arrived yet are waited for until timeout; a timeout of 0 checks the history once.
+func (c *ChatAssertion) ToHaveSeen(pattern interface{}, timeout time.Duration, options *ChatOptions) *types.ChatMessage {
+	if options == nil {
+		options = &ChatOptions{}
+	}
+
+	var found *types.ChatMessage
+	var history []types.ChatMessage
+	waitUntil(c.agent.Emitter(), []events.EventName{events.EventChat}, func() bool {
+		history = c.agent.ChatHistory()
+		idx := slices.IndexFunc(history, func(msg types.ChatMessage) bool {
+			return options.matches(&msg, pattern)
+		})
+		if idx < 0 {
+			return false
+		}
+		found = &history[idx]
+		return true
+	}, timeout)
+
+	if found == nil {
+		seen := make([]string, len(history))
+		for i, msg := range history {
+			seen[i] = msg.Message
+		}
+		panic(NewAssertionError(
+			fmt.Sprintf("expected chat history to contain a message matching %v within %v", pattern, timeout),
+			pattern,
+			seen,
+		))
+	}
+	return found
+}
+
 // ChatOptions provides options for chat assertions
 type ChatOptions struct {
 	From string
+	// DirectedOnly ignores broadcasts and only accepts messages directed at the asserting agent
+	// In multi-agent tests every bot sees server broadcasts, so without it a message meant for
+	// another agent can satisfy the assertion. See isDirected for how direction is decided.
+	DirectedOnly bool
+}
+
+// matches reports whether msg passes the options and matches pattern
+func (o *ChatOptions) matches(msg *types.ChatMessage, pattern interface{}) bool {
+	if o.From != "" && msg.Sender != o.From {
+		return false
+	}
+	if o.DirectedOnly && !isDirected(msg) {
+		return false
+	}
+	return matchesPattern(msg.Message, pattern)
+}
+
+// formattingCodePattern matches Minecraft formatting codes such as §a, which would hide word boundaries
+var formattingCodePattern = regexp.MustCompile(`§.`)
+
+// isDirected reports whether a message was addressed to the agent that received it
+// Whispers are always directed. Other messages carry no recipient in the protocol, so they count
+// as directed only when they mention the recipient's name as a whole word (so "Bot" is not
+// mentioned by "Bot2"); the agent's own chat echo never does.
+func isDirected(msg *types.ChatMessage) bool {
+	if msg.Recipient == "" || msg.Sender == msg.Recipient {
+		return false
+	}
+	if msg.Type == "whisper" {
+		return true
+	}
+	mention := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(msg.Recipient) + `\b`)
+	return mention.MatchString(formattingCodePattern.ReplaceAllString(msg.Message, ""))
 }
 
 func matchesPattern(text string, pattern interface{}) bool {
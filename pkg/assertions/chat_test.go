@@ -0,0 +1,34 @@
+package assertions
+
+import (
+	"testing"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+func TestIsDirected(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.ChatMessage
+		want bool
+	}{
+		{"whisper", types.ChatMessage{Type: "whisper", Sender: "Admin", Recipient: "Bot", Message: "hi"}, true},
+		{"mentioned", types.ChatMessage{Type: "chat", Sender: "Admin", Recipient: "Bot", Message: "hello Bot!"}, true},
+		{"mentioned in other case", types.ChatMessage{Type: "raw", Recipient: "Bot", Message: "BOT joined"}, true},
+		{"mentioned after colour code", types.ChatMessage{Type: "raw", Recipient: "Bot", Message: "§aBot§r won"}, true},
+		{"name with underscore", types.ChatMessage{Type: "raw", Recipient: "Bot_1", Message: "Bot_1 won"}, true},
+		{"name inside another name", types.ChatMessage{Type: "raw", Recipient: "Bot", Message: "Bot2 won"}, false},
+		{"name inside a word", types.ChatMessage{Type: "raw", Recipient: "Bot", Message: "robots unite"}, false},
+		{"regexp characters in name", types.ChatMessage{Type: "raw", Recipient: "B.t", Message: "Bot won"}, false},
+		{"own echo", types.ChatMessage{Type: "chat", Sender: "Bot", Recipient: "Bot", Message: "I am Bot"}, false},
+		{"no recipient", types.ChatMessage{Type: "raw", Message: "Bot won"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDirected(&tt.msg); got != tt.want {
+				t.Errorf("isDirected(%q) = %v, want %v", tt.msg.Message, got, tt.want)
+			}
+		})
+	}
+}
@@ -70,6 +70,35 @@ func (c *CommandOutputAssertion) ToReceiveAny(timeout time.Duration) *types.Comm
 	return data.(*types.CommandOutput)
 }
 
+// ToReceiveForCommand waits for the CommandOutput of a specific command
+// Outputs are attributed by the request's origin UUID, so this works while several commands are
+// in flight. It requires commandSendMethod "request"; outputs of text commands carry no command.
+func (c *CommandOutputAssertion) ToReceiveForCommand(cmd string, timeout time.Duration) *types.CommandOutput {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	commandLine := strings.TrimPrefix(strings.TrimSpace(cmd), "/")
+
+	filter := func(data events.EventData) bool {
+		output, ok := data.(*types.CommandOutput)
+		if !ok {
+			return false
+		}
+		return output.Command == commandLine
+	}
+
+	data, err := c.agent.Emitter().WaitFor(ctx, events.EventCommandOutput, filter)
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for CommandOutput of command %q", "/"+commandLine),
+			commandLine,
+			nil,
+		))
+	}
+
+	return data.(*types.CommandOutput)
+}
+
 // ToContain waits for a CommandOutput containing the expected text
 func (c *CommandOutputAssertion) ToContain(expected string, timeout time.Duration) *types.CommandOutput {
 	return c.ToReceive(expected, timeout, nil)
@@ -105,9 +134,14 @@ func (c *CommandOutputAssertion) ToReceiveWithStatusCode(statusCode int32, timeo
 	return data.(*types.CommandOutput)
 }
 
-// ToReceiveSuccess waits for a successful CommandOutput (StatusCode == 0)
+// ToReceiveSuccess waits for a successful CommandOutput (StatusCode == types.CommandStatusSuccess)
 func (c *CommandOutputAssertion) ToReceiveSuccess(timeout time.Duration) *types.CommandOutput {
-	return c.ToReceiveWithStatusCode(0, timeout)
+	return c.ToReceiveWithStatusCode(types.CommandStatusSuccess, timeout)
+}
+
+// ToReceiveFailure waits for a failed CommandOutput (StatusCode == types.CommandStatusFailure)
+func (c *CommandOutputAssertion) ToReceiveFailure(timeout time.Duration) *types.CommandOutput {
+	return c.ToReceiveWithStatusCode(types.CommandStatusFailure, timeout)
 }
 
 // NotToReceive asserts that no matching CommandOutput is received within duration
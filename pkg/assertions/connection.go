@@ -0,0 +1,57 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+)
+
+// ConnectionAssertion provides assertions on the agent's connection over time
+type ConnectionAssertion struct {
+	agent AgentInterface
+}
+
+// ToStayConnected checks that the agent stays connected for the whole duration
+// It fails as soon as the server disconnects the agent (EventDisconnect), and also if the agent is
+// not connected at the start or end of the window.
+func (c *ConnectionAssertion) ToStayConnected(duration time.Duration) {
+	// Subscribe before checking so a disconnect right after the check is not missed
+	disconnected := make(chan events.EventData, 1)
+	handlerID := c.agent.Emitter().OnSync(events.EventDisconnect, func(data events.EventData) {
+		select {
+		case disconnected <- data:
+		default:
+		}
+	})
+	defer c.agent.Emitter().Off(events.EventDisconnect, handlerID)
+
+	if !c.agent.IsConnected() {
+		panic(NewAssertionError(
+			"expected player to stay connected, but it is not connected",
+			"connected",
+			"disconnected",
+		))
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case reason := <-disconnected:
+		panic(NewAssertionError(
+			fmt.Sprintf("expected player to stay connected for %v, but it was disconnected: %v", duration, reason),
+			"connected",
+			"disconnected",
+		))
+	case <-timer.C:
+	}
+
+	if !c.agent.IsConnected() {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected player to stay connected for %v, but it was disconnected", duration),
+			"connected",
+			"disconnected",
+		))
+	}
+}
@@ -1,5 +1,7 @@
 package assertions
 
+import "github.com/gollilla/best/pkg/types"
+
 // AssertionContext provides assertion methods for an agent
 type AssertionContext struct {
 	agent AgentInterface
@@ -10,6 +12,7 @@ type AssertionContext struct {
 	commandOutputAssertion  *CommandOutputAssertion
 	inventoryAssertion      *InventoryAssertion
 	formAssertion           *FormAssertion
+	connectionAssertion     *ConnectionAssertion
 
 	// Player state assertions
 	healthAssertion     *HealthAssertion
@@ -18,6 +21,16 @@ type AssertionContext struct {
 	gamemodeAssertion   *GamemodeAssertion
 	permissionAssertion *PermissionAssertion
 	tagAssertion        *TagAssertion
+	playerListAssertion *PlayerListAssertion
+	deathAssertion      *DeathAssertion
+	heldItemAssertion   *HeldItemAssertion
+	soundAssertion      *SoundAssertion
+	particleAssertion   *ParticleAssertion
+	bossBarAssertion    *BossBarAssertion
+	packetAssertion     *PacketAssertion
+	experienceAssertion *ExperienceAssertion
+	weatherAssertion    *WeatherAssertion
+	timeAssertion       *TimeAssertion
 
 	// UI/Display assertions
 	titleAssertion      *TitleAssertion
@@ -38,6 +51,7 @@ func NewAssertionContext(a AgentInterface) *AssertionContext {
 	ctx.commandOutputAssertion = &CommandOutputAssertion{agent: a}
 	ctx.inventoryAssertion = &InventoryAssertion{agent: a}
 	ctx.formAssertion = &FormAssertion{agent: a}
+	ctx.connectionAssertion = &ConnectionAssertion{agent: a}
 
 	// Initialize player state assertions
 	ctx.healthAssertion = &HealthAssertion{agent: a}
@@ -46,6 +60,16 @@ func NewAssertionContext(a AgentInterface) *AssertionContext {
 	ctx.gamemodeAssertion = &GamemodeAssertion{agent: a}
 	ctx.permissionAssertion = &PermissionAssertion{agent: a}
 	ctx.tagAssertion = &TagAssertion{agent: a}
+	ctx.playerListAssertion = &PlayerListAssertion{agent: a}
+	ctx.deathAssertion = &DeathAssertion{agent: a}
+	ctx.heldItemAssertion = &HeldItemAssertion{agent: a}
+	ctx.soundAssertion = &SoundAssertion{agent: a}
+	ctx.particleAssertion = &ParticleAssertion{agent: a}
+	ctx.bossBarAssertion = &BossBarAssertion{agent: a}
+	ctx.packetAssertion = &PacketAssertion{agent: a}
+	ctx.experienceAssertion = &ExperienceAssertion{agent: a}
+	ctx.weatherAssertion = &WeatherAssertion{agent: a}
+	ctx.timeAssertion = &TimeAssertion{agent: a}
 
 	// Initialize UI/Display assertions
 	ctx.titleAssertion = &TitleAssertion{agent: a}
@@ -82,6 +106,18 @@ func (c *AssertionContext) ToBeDisconnected() error {
 	return nil
 }
 
+// ToBeSpawned asserts that the agent has completed the spawn sequence
+func (c *AssertionContext) ToBeSpawned() error {
+	if !c.agent.IsSpawned() {
+		return NewAssertionError(
+			"Expected player to be spawned",
+			"spawned",
+			"not spawned",
+		)
+	}
+	return nil
+}
+
 // === Getter methods for specific assertion types ===
 
 // Position returns position assertions
@@ -110,6 +146,12 @@ func (c *AssertionContext) Form() *FormAssertion {
 	return c.formAssertion
 }
 
+// Connection returns connection assertions
+// Use this to check that the agent stays connected over a period of time
+func (c *AssertionContext) Connection() *ConnectionAssertion {
+	return c.connectionAssertion
+}
+
 // === Player state assertion getters ===
 
 // Health returns health assertions
@@ -142,6 +184,64 @@ func (c *AssertionContext) Tag() *TagAssertion {
 	return c.tagAssertion
 }
 
+// PlayerList returns player list assertions
+// Use this to detect other players (or agents) joining and leaving
+func (c *AssertionContext) PlayerList() *PlayerListAssertion {
+	return c.playerListAssertion
+}
+
+// Death returns death assertions
+func (c *AssertionContext) Death() *DeathAssertion {
+	return c.deathAssertion
+}
+
+// HeldItem returns assertions on the item in the selected hotbar slot
+func (c *AssertionContext) HeldItem() *HeldItemAssertion {
+	return c.heldItemAssertion
+}
+
+// Sound returns assertions on sounds played to the agent
+func (c *AssertionContext) Sound() *SoundAssertion {
+	return c.soundAssertion
+}
+
+// Particle returns assertions on particles spawned near the agent
+func (c *AssertionContext) Particle() *ParticleAssertion {
+	return c.particleAssertion
+}
+
+// BossBar returns assertions on the boss bars shown to the agent
+func (c *AssertionContext) BossBar() *BossBarAssertion {
+	return c.bossBarAssertion
+}
+
+// Packet returns assertions on raw packet counts
+func (c *AssertionContext) Packet() *PacketAssertion {
+	return c.packetAssertion
+}
+
+// Experience returns experience level assertions
+func (c *AssertionContext) Experience() *ExperienceAssertion {
+	return c.experienceAssertion
+}
+
+// Weather returns weather assertions
+func (c *AssertionContext) Weather() *WeatherAssertion {
+	return c.weatherAssertion
+}
+
+// Time returns world time assertions
+func (c *AssertionContext) Time() *TimeAssertion {
+	return c.timeAssertion
+}
+
+// === World assertion getters ===
+
+// Block returns assertions on the block at the given position
+func (c *AssertionContext) Block(pos types.Position) *BlockAssertion {
+	return &BlockAssertion{agent: c.agent, pos: pos}
+}
+
 // === UI/Display assertion getters ===
 
 // Title returns title assertions
@@ -0,0 +1,54 @@
+package assertions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// DeathAssertion provides assertions on the agent dying
+type DeathAssertion struct {
+	agent AgentInterface
+}
+
+// ToOccur waits for the agent to die within the timeout and returns the death
+// Only deaths after the call count; check GetDeathCount for earlier ones.
+// The cause may be empty if the server sends it after health 0; GetLastDeath includes it.
+func (d *DeathAssertion) ToOccur(timeout time.Duration) *types.Death {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	data, err := d.agent.Emitter().WaitFor(ctx, events.EventDeath, nil)
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for player to die within %v", timeout),
+			"death",
+			"alive",
+		))
+	}
+
+	death, ok := data.(*types.Death)
+	if !ok {
+		panic(NewAssertionError(
+			"Expected death data to be *types.Death",
+			"*types.Death",
+			fmt.Sprintf("%T", data),
+		))
+	}
+	return death
+}
+
+// ToHaveCount checks how often the agent has died since connecting
+func (d *DeathAssertion) ToHaveCount(expected int) {
+	actual := d.agent.GetDeathCount()
+	if actual != expected {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected player to have died %d time(s), but died %d time(s)", expected, actual),
+			expected,
+			actual,
+		))
+	}
+}
@@ -0,0 +1,44 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+)
+
+// ExperienceAssertion provides experience level assertions
+type ExperienceAssertion struct {
+	agent AgentInterface
+}
+
+// level returns the current experience level
+func (e *ExperienceAssertion) level() int32 {
+	return e.agent.State().Experience.Level
+}
+
+// ToBeAtLeast checks that the experience level is at least min
+func (e *ExperienceAssertion) ToBeAtLeast(min int32) {
+	actual := pollState(e.level, func(level int32) bool { return level >= min })
+
+	if actual < min {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected experience level to be at least %d", min),
+			fmt.Sprintf(">= %d", min),
+			actual,
+		))
+	}
+}
+
+// ToReach waits for the experience level to reach at least level within the timeout
+// It passes immediately if the level is already reached.
+func (e *ExperienceAssertion) ToReach(level int32, timeout time.Duration) {
+	check := func() bool { return e.level() >= level }
+	if !waitUntil(e.agent.Emitter(), []events.EventName{events.EventExperienceUpdate}, check, timeout) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for experience level %d within %v", level, timeout),
+			fmt.Sprintf(">= %d", level),
+			e.level(),
+		))
+	}
+}
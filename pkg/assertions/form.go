@@ -3,6 +3,7 @@ package assertions
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -43,6 +44,22 @@ func (f *FormAssertion) ToReceive(timeout time.Duration) *FormAssertion {
 	return f
 }
 
+// ToHaveReceived asserts on the most recently received form that is still pending
+// Unlike ToReceive it does not wait, so it can check a form that arrived during an earlier step.
+func (f *FormAssertion) ToHaveReceived() *FormAssertion {
+	form := f.agent.GetLastForm()
+	if form == nil {
+		panic(NewAssertionError(
+			"Expected a form to have been received, but there is none",
+			"form received",
+			"nil",
+		))
+	}
+
+	f.form = form
+	return f
+}
+
 // ToReceiveWithTitle waits for a form with the specific title within the timeout
 func (f *FormAssertion) ToReceiveWithTitle(title string, timeout time.Duration) *FormAssertion {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -241,6 +258,53 @@ func (f *FormAssertion) ToHaveButtons(count int) *FormAssertion {
 
 // ToHaveContent asserts that the form has the expected content text
 func (f *FormAssertion) ToHaveContent(expected string) *FormAssertion {
+	content := f.content("ToHaveContent")
+
+	if content != expected {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected form content to be %q, but was %q", expected, content),
+			expected,
+			content,
+		))
+	}
+
+	return f
+}
+
+// ToContainContent asserts that the form content contains substring
+// Useful for info dialogs whose body includes dynamic values such as stats or timestamps
+func (f *FormAssertion) ToContainContent(substring string) *FormAssertion {
+	content := f.content("ToContainContent")
+
+	if !strings.Contains(content, substring) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected form content to contain %q, but was %q", substring, content),
+			substring,
+			content,
+		))
+	}
+
+	return f
+}
+
+// ToMatchContent asserts that the form content matches pattern
+// Use (?s) in the pattern to let . match across lines of multi-line content
+func (f *FormAssertion) ToMatchContent(pattern *regexp.Regexp) *FormAssertion {
+	content := f.content("ToMatchContent")
+
+	if !pattern.MatchString(content) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected form content to match %q, but was %q", pattern.String(), content),
+			pattern.String(),
+			content,
+		))
+	}
+
+	return f
+}
+
+// content returns the content text of the form being asserted, which must be a ModalForm or ActionForm
+func (f *FormAssertion) content(assertion string) string {
 	if f.form == nil {
 		panic(NewAssertionError(
 			"No form received yet. Call ToReceive() first",
@@ -249,31 +313,259 @@ func (f *FormAssertion) ToHaveContent(expected string) *FormAssertion {
 		))
 	}
 
-	var content string
 	switch form := f.form.(type) {
 	case *types.ModalForm:
-		content = form.Content
+		return form.Content
 	case *types.ActionForm:
-		content = form.Content
+		return form.Content
 	default:
 		panic(NewAssertionError(
-			"ToHaveContent can only be used with ModalForm or ActionForm",
+			assertion+" can only be used with ModalForm or ActionForm",
 			"ModalForm or ActionForm",
 			f.form.GetType(),
 		))
 	}
+}
 
-	if content != expected {
+// ToHaveToggleDefault asserts that the custom form has a toggle with the given label and default state
+func (f *FormAssertion) ToHaveToggleDefault(label string, expected bool) *FormAssertion {
+	toggle, ok := f.customElement(label).(*types.Toggle)
+	if !ok {
 		panic(NewAssertionError(
-			fmt.Sprintf("Expected form content to be %q, but was %q", expected, content),
+			fmt.Sprintf("Expected element %q to be a toggle", label),
+			"toggle",
+			f.customElement(label).GetType(),
+		))
+	}
+
+	if toggle.Default != expected {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected toggle %q default to be %v, but was %v", label, expected, toggle.Default),
 			expected,
-			content,
+			toggle.Default,
+		))
+	}
+
+	return f
+}
+
+// ToHaveSliderRange asserts that the custom form has a slider with the given label and min/max
+func (f *FormAssertion) ToHaveSliderRange(label string, min, max float64) *FormAssertion {
+	slider := f.slider(label)
+	if slider.Min != min || slider.Max != max {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected slider %q range to be %v-%v, but was %v-%v", label, min, max, slider.Min, slider.Max),
+			fmt.Sprintf("%v-%v", min, max),
+			fmt.Sprintf("%v-%v", slider.Min, slider.Max),
 		))
 	}
 
 	return f
 }
 
+// ToHaveSliderStep asserts that the custom form has a slider with the given label and step size
+func (f *FormAssertion) ToHaveSliderStep(label string, step float64) *FormAssertion {
+	slider := f.slider(label)
+	if slider.Step != step {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected slider %q step to be %v, but was %v", label, step, slider.Step),
+			step,
+			slider.Step,
+		))
+	}
+	return f
+}
+
+// ToHaveSliderDefault asserts that the custom form has a slider with the given label and default value
+func (f *FormAssertion) ToHaveSliderDefault(label string, expected float64) *FormAssertion {
+	slider := f.slider(label)
+	if slider.Default != expected {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected slider %q default to be %v, but was %v", label, expected, slider.Default),
+			expected,
+			slider.Default,
+		))
+	}
+	return f
+}
+
+// slider returns the custom form slider with the given label
+func (f *FormAssertion) slider(label string) *types.Slider {
+	elem := f.customElement(label)
+	slider, ok := elem.(*types.Slider)
+	if !ok {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected element %q to be a slider", label),
+			"slider",
+			elem.GetType(),
+		))
+	}
+	return slider
+}
+
+// ToHaveInputDefault asserts that the custom form has an input with the given label and default value
+func (f *FormAssertion) ToHaveInputDefault(label string, expected string) *FormAssertion {
+	input, ok := f.customElement(label).(*types.Input)
+	if !ok {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected element %q to be an input", label),
+			"input",
+			f.customElement(label).GetType(),
+		))
+	}
+
+	if input.Default != expected {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected input %q default to be %q, but was %q", label, expected, input.Default),
+			expected,
+			input.Default,
+		))
+	}
+
+	return f
+}
+
+// ToHaveDropdownDefault asserts that the custom form has a dropdown with the given label and default option
+func (f *FormAssertion) ToHaveDropdownDefault(label string, expected string) *FormAssertion {
+	dropdown, ok := f.customElement(label).(*types.Dropdown)
+	if !ok {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected element %q to be a dropdown", label),
+			"dropdown",
+			f.customElement(label).GetType(),
+		))
+	}
+
+	actual := ""
+	if dropdown.Default >= 0 && dropdown.Default < len(dropdown.Options) {
+		actual = dropdown.Options[dropdown.Default]
+	}
+	if actual != expected {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected dropdown %q default to be %q, but was %q", label, expected, actual),
+			expected,
+			actual,
+		))
+	}
+
+	return f
+}
+
+// ToHaveInput asserts that the custom form has an input with the given label text
+func (f *FormAssertion) ToHaveInput(label string) *FormAssertion {
+	if elem := f.customElement(label); elem.GetType() != "input" {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected element %q to be an input", label),
+			"input",
+			elem.GetType(),
+		))
+	}
+	return f
+}
+
+// ToHaveToggle asserts that the custom form has a toggle with the given label text
+func (f *FormAssertion) ToHaveToggle(label string) *FormAssertion {
+	if elem := f.customElement(label); elem.GetType() != "toggle" {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected element %q to be a toggle", label),
+			"toggle",
+			elem.GetType(),
+		))
+	}
+	return f
+}
+
+// ToHaveDropdownWith asserts that the custom form has a dropdown offering the given option
+func (f *FormAssertion) ToHaveDropdownWith(option string) *FormAssertion {
+	customForm := f.customForm()
+
+	var options []string
+	for _, elem := range customForm.Content {
+		dropdown, ok := elem.(*types.Dropdown)
+		if !ok {
+			continue
+		}
+		for _, opt := range dropdown.Options {
+			if opt == option {
+				return f
+			}
+		}
+		options = append(options, dropdown.Options...)
+	}
+
+	panic(NewAssertionError(
+		fmt.Sprintf("Expected form to have a dropdown with option %q, but it was not found", option),
+		fmt.Sprintf("option %q", option),
+		options,
+	))
+}
+
+// customElement returns the custom form element with the given label text (see findFormElement)
+func (f *FormAssertion) customElement(label string) types.FormElement {
+	customForm := f.customForm()
+
+	if elem := findFormElement(customForm, label); elem != nil {
+		return elem
+	}
+
+	panic(NewAssertionError(
+		fmt.Sprintf("Expected form to have element %q, but it was not found", label),
+		fmt.Sprintf("element %q", label),
+		"not found",
+	))
+}
+
+// customForm returns the form being asserted, which must be a custom form
+func (f *FormAssertion) customForm() *types.CustomForm {
+	if f.form == nil {
+		panic(NewAssertionError(
+			"No form received yet. Call ToReceive() first",
+			"form received",
+			"nil",
+		))
+	}
+
+	customForm, ok := f.form.(*types.CustomForm)
+	if !ok {
+		panic(NewAssertionError(
+			"Form element assertions can only be used with CustomForm",
+			"CustomForm",
+			f.form.GetType(),
+		))
+	}
+	return customForm
+}
+
+// findFormElement returns the custom form element whose label text equals label, or nil
+func findFormElement(form *types.CustomForm, label string) types.FormElement {
+	for _, elem := range form.Content {
+		if formElementText(elem) == label {
+			return elem
+		}
+	}
+	return nil
+}
+
+// formElementText returns the label text of a custom form element
+func formElementText(elem types.FormElement) string {
+	switch e := elem.(type) {
+	case *types.Label:
+		return e.Text
+	case *types.Input:
+		return e.Text
+	case *types.Toggle:
+		return e.Text
+	case *types.Slider:
+		return e.Text
+	case *types.Dropdown:
+		return e.Text
+	case *types.StepSlider:
+		return e.Text
+	default:
+		return ""
+	}
+}
+
 // GetForm returns the current form being asserted
 func (f *FormAssertion) GetForm() types.Form {
 	return f.form
@@ -99,6 +99,19 @@ func (g *GamemodeAssertion) ToChangeTo(expected int32, timeout time.Duration) {
 	}
 }
 
+// ToBeWithin checks that the gamemode is expected now or becomes it within the timeout
+// Use this after /gamemode, whose SetPlayerGameType packet arrives after the command output.
+func (g *GamemodeAssertion) ToBeWithin(expected int32, timeout time.Duration) {
+	check := func() bool { return g.agent.Gamemode() == expected }
+	if !waitUntil(g.agent.Emitter(), []events.EventName{events.EventGamemodeUpdate}, check, timeout) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for gamemode %s (%d) within %v", gamemodeName(expected), expected, timeout),
+			gamemodeName(expected),
+			gamemodeName(g.agent.Gamemode()),
+		))
+	}
+}
+
 // Helper function
 
 // gamemodeName returns the string name for a gamemode value
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
 )
 
 // HealthAssertion provides health-related assertions
@@ -13,13 +14,18 @@ type HealthAssertion struct {
 	agent AgentInterface
 }
 
+// hearts renders a health value against the agent's maximum health for failure messages
+func (h *HealthAssertion) hearts(health float32) string {
+	return types.FormatHearts(health, h.agent.State().MaxHealth)
+}
+
 // ToBe checks if the health is exactly the expected value
 func (h *HealthAssertion) ToBe(expected float32) {
-	actual := h.agent.Health()
+	actual := pollState(h.agent.Health, func(health float32) bool { return health == expected })
 
 	if actual != expected {
 		panic(NewAssertionError(
-			fmt.Sprintf("expected health to be %.1f", expected),
+			fmt.Sprintf("expected health to be %.1f, but was %s", expected, h.hearts(actual)),
 			expected,
 			actual,
 		))
@@ -28,11 +34,11 @@ func (h *HealthAssertion) ToBe(expected float32) {
 
 // ToBeAbove checks if the health is above the minimum value
 func (h *HealthAssertion) ToBeAbove(min float32) {
-	actual := h.agent.Health()
+	actual := pollState(h.agent.Health, func(health float32) bool { return health > min })
 
 	if actual <= min {
 		panic(NewAssertionError(
-			fmt.Sprintf("expected health to be above %.1f", min),
+			fmt.Sprintf("expected health to be above %.1f, but was %s", min, h.hearts(actual)),
 			fmt.Sprintf("> %.1f", min),
 			actual,
 		))
@@ -41,31 +47,40 @@ func (h *HealthAssertion) ToBeAbove(min float32) {
 
 // ToBeBelow checks if the health is below the maximum value
 func (h *HealthAssertion) ToBeBelow(max float32) {
-	actual := h.agent.Health()
+	actual := pollState(h.agent.Health, func(health float32) bool { return health < max })
 
 	if actual >= max {
 		panic(NewAssertionError(
-			fmt.Sprintf("expected health to be below %.1f", max),
+			fmt.Sprintf("expected health to be below %.1f, but was %s", max, h.hearts(actual)),
 			fmt.Sprintf("< %.1f", max),
 			actual,
 		))
 	}
 }
 
-// ToBeFull checks if the health is at maximum (20.0)
+// ToBeFull checks if the health is at the maximum of the health attribute (20 unless changed by the server)
 func (h *HealthAssertion) ToBeFull() {
-	const maxHealth = 20.0
-	actual := h.agent.Health()
+	state := pollState(h.agent.State, func(state types.PlayerState) bool {
+		return state.Health == fullHealth(state)
+	})
 
-	if actual != maxHealth {
+	if maxHealth := fullHealth(state); state.Health != maxHealth {
 		panic(NewAssertionError(
-			"expected health to be full (20.0)",
+			fmt.Sprintf("expected health to be full (%g), but was %s", maxHealth, types.FormatHearts(state.Health, maxHealth)),
 			maxHealth,
-			actual,
+			state.Health,
 		))
 	}
 }
 
+// fullHealth returns the maximum health of state, 20 if the server has not sent it
+func fullHealth(state types.PlayerState) float32 {
+	if state.MaxHealth <= 0 {
+		return 20
+	}
+	return state.MaxHealth
+}
+
 // ToChange waits for health to change within the timeout
 func (h *HealthAssertion) ToChange(timeout time.Duration) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -0,0 +1,49 @@
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+// HeldItemAssertion provides assertions on the item in the selected hotbar slot
+type HeldItemAssertion struct {
+	agent AgentInterface
+}
+
+// ToBe checks that the held item matches itemID
+// itemID can be a full ID (e.g., "minecraft:diamond_sword") or a partial match (e.g., "diamond_sword").
+func (h *HeldItemAssertion) ToBe(itemID string) {
+	item := pollState(h.agent.HeldItem, func(item *types.InventoryItem) bool {
+		return item != nil && FuzzyItemMatcher(item.ID, itemID)
+	})
+	if item == nil || !FuzzyItemMatcher(item.ID, itemID) {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected player to hold %q", itemID),
+			itemID,
+			heldItemDescription(item),
+		))
+	}
+}
+
+// ToBeEmpty checks that the selected hotbar slot is empty
+func (h *HeldItemAssertion) ToBeEmpty() {
+	item := pollState(h.agent.HeldItem, func(item *types.InventoryItem) bool {
+		return item == nil
+	})
+	if item != nil {
+		panic(NewAssertionError(
+			"expected player to hold nothing",
+			"nothing",
+			heldItemDescription(item),
+		))
+	}
+}
+
+// heldItemDescription describes the held item for assertion errors
+func heldItemDescription(item *types.InventoryItem) string {
+	if item == nil {
+		return "nothing"
+	}
+	return fmt.Sprintf("%s x%d (slot %d)", item.ID, item.Count, item.Slot)
+}
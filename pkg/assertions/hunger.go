@@ -15,7 +15,7 @@ type HungerAssertion struct {
 
 // ToBe checks if the hunger is exactly the expected value
 func (h *HungerAssertion) ToBe(expected float32) {
-	actual := h.agent.GetHunger()
+	actual := pollState(h.agent.GetHunger, func(hunger float32) bool { return hunger == expected })
 
 	if actual != expected {
 		panic(NewAssertionError(
@@ -28,7 +28,7 @@ func (h *HungerAssertion) ToBe(expected float32) {
 
 // ToBeAbove checks if the hunger is above the minimum value
 func (h *HungerAssertion) ToBeAbove(min float32) {
-	actual := h.agent.GetHunger()
+	actual := pollState(h.agent.GetHunger, func(hunger float32) bool { return hunger > min })
 
 	if actual <= min {
 		panic(NewAssertionError(
@@ -41,7 +41,7 @@ func (h *HungerAssertion) ToBeAbove(min float32) {
 
 // ToBeBelow checks if the hunger is below the maximum value
 func (h *HungerAssertion) ToBeBelow(max float32) {
-	actual := h.agent.GetHunger()
+	actual := pollState(h.agent.GetHunger, func(hunger float32) bool { return hunger < max })
 
 	if actual >= max {
 		panic(NewAssertionError(
@@ -55,7 +55,7 @@ func (h *HungerAssertion) ToBeBelow(max float32) {
 // ToBeFull checks if the hunger is at maximum (20.0)
 func (h *HungerAssertion) ToBeFull() {
 	const maxHunger = 20.0
-	actual := h.agent.GetHunger()
+	actual := pollState(h.agent.GetHunger, func(hunger float32) bool { return hunger == maxHunger })
 
 	if actual != maxHunger {
 		panic(NewAssertionError(
@@ -10,20 +10,58 @@ import (
 	"github.com/gollilla/best/pkg/types"
 )
 
+// ItemMatcher reports whether an inventory item ID matches the ID given to an assertion
+type ItemMatcher func(actualID, expectedID string) bool
+
 // InventoryAssertion provides inventory-related assertions
 type InventoryAssertion struct {
-	agent AgentInterface
+	agent   AgentInterface
+	matcher ItemMatcher // nil = FuzzyItemMatcher
+}
+
+// Exact returns inventory assertions that only match item IDs exactly
+// A missing namespace defaults to "minecraft", so "gold_ingot" matches "minecraft:gold_ingot"
+// but "gold" matches neither "minecraft:gold_ingot" nor "minecraft:gold_block".
+func (i *InventoryAssertion) Exact() *InventoryAssertion {
+	return i.WithMatcher(ExactItemMatcher)
+}
+
+// WithMatcher returns inventory assertions that compare item IDs with matcher
+func (i *InventoryAssertion) WithMatcher(matcher ItemMatcher) *InventoryAssertion {
+	return &InventoryAssertion{
+		agent:   i.agent,
+		matcher: matcher,
+	}
+}
+
+// matches compares item IDs with the configured matcher
+func (i *InventoryAssertion) matches(actualID, expectedID string) bool {
+	if i.matcher == nil {
+		return FuzzyItemMatcher(actualID, expectedID)
+	}
+	return i.matcher(actualID, expectedID)
+}
+
+// countItem returns the total count of items matching itemID
+func (i *InventoryAssertion) countItem(items []types.InventoryItem, itemID string) int32 {
+	var total int32
+	for _, item := range items {
+		if i.matches(item.ID, itemID) {
+			total += item.Count
+		}
+	}
+	return total
 }
 
 // ToHaveItem checks if the inventory contains a specific item
 // itemID can be a full ID (e.g., "minecraft:diamond") or a partial match (e.g., "diamond")
 func (i *InventoryAssertion) ToHaveItem(itemID string) {
-	items := i.agent.GetInventory()
+	items := pollState(i.agent.GetInventory, func(items []types.InventoryItem) bool {
+		return i.countItem(items, itemID) > 0
+	})
 
-	for _, item := range items {
-		if matchesItemID(item.ID, itemID) {
-			return
-		}
+	if i.countItem(items, itemID) > 0 {
+		return
 	}
 
 	panic(NewAssertionError(
@@ -35,15 +73,12 @@ func (i *InventoryAssertion) ToHaveItem(itemID string) {
 
 // ToHaveItemCount checks if the inventory contains a specific count of an item
 func (i *InventoryAssertion) ToHaveItemCount(itemID string, expectedCount int32) {
-	items := i.agent.GetInventory()
-
-	var totalCount int32
-	for _, item := range items {
-		if matchesItemID(item.ID, itemID) {
-			totalCount += item.Count
-		}
-	}
+	items := pollState(i.agent.GetInventory, func(items []types.InventoryItem) bool {
+		totalCount := i.countItem(items, itemID)
+		return totalCount == expectedCount
+	})
 
+	totalCount := i.countItem(items, itemID)
 	if totalCount == expectedCount {
 		return
 	}
@@ -57,15 +92,12 @@ func (i *InventoryAssertion) ToHaveItemCount(itemID string, expectedCount int32)
 
 // ToHaveAtLeast checks if the inventory contains at least a certain count of an item
 func (i *InventoryAssertion) ToHaveAtLeast(itemID string, minCount int32) {
-	items := i.agent.GetInventory()
-
-	var totalCount int32
-	for _, item := range items {
-		if matchesItemID(item.ID, itemID) {
-			totalCount += item.Count
-		}
-	}
+	items := pollState(i.agent.GetInventory, func(items []types.InventoryItem) bool {
+		totalCount := i.countItem(items, itemID)
+		return totalCount >= minCount
+	})
 
+	totalCount := i.countItem(items, itemID)
 	if totalCount >= minCount {
 		return
 	}
@@ -79,7 +111,9 @@ func (i *InventoryAssertion) ToHaveAtLeast(itemID string, minCount int32) {
 
 // ToBeEmpty checks if the inventory is empty
 func (i *InventoryAssertion) ToBeEmpty() {
-	items := i.agent.GetInventory()
+	items := pollState(i.agent.GetInventory, func(items []types.InventoryItem) bool {
+		return len(items) == 0
+	})
 
 	if len(items) == 0 {
 		return
@@ -101,7 +135,7 @@ func (i *InventoryAssertion) ToReceiveItem(ctx context.Context, itemID string) *
 		}
 
 		for _, item := range items {
-			if matchesItemID(item.ID, itemID) {
+			if i.matches(item.ID, itemID) {
 				return true
 			}
 		}
@@ -114,7 +148,7 @@ func (i *InventoryAssertion) ToReceiveItem(ctx context.Context, itemID string) *
 
 	items := data.([]types.InventoryItem)
 	for _, item := range items {
-		if matchesItemID(item.ID, itemID) {
+		if i.matches(item.ID, itemID) {
 			return &item
 		}
 	}
@@ -157,17 +191,25 @@ func (i *InventoryAssertion) WaitForInventoryChange(timeout time.Duration) {
 
 // Helper functions
 
-// matchesItemID checks if an item ID matches the expected pattern
+// FuzzyItemMatcher is the default ItemMatcher
 // Supports:
 // - Full IDs (minecraft:diamond, item:335)
 // - Partial matches (diamond matches minecraft:diamond)
 // - Network IDs (335 matches item:335)
-func matchesItemID(actualID, expectedID string) bool {
+// - Aliases (wood matches minecraft:spruce_planks), once enabled with EnableAliases
+//
+// Partial matching is convenient but ambiguous: "gold" matches both gold_ingot and gold_block.
+// Use ExactItemMatcher (InventoryAssertion.Exact) when that matters.
+func FuzzyItemMatcher(actualID, expectedID string) bool {
 	// Exact match
 	if actualID == expectedID {
 		return true
 	}
 
+	if matchesAlias(actualID, expectedID) {
+		return true
+	}
+
 	// Check if expectedID is a numeric ID (e.g., "335" matches "item:335")
 	if strings.HasPrefix(actualID, "item:") && strings.TrimPrefix(actualID, "item:") == expectedID {
 		return true
@@ -189,6 +231,34 @@ func matchesItemID(actualID, expectedID string) bool {
 	return false
 }
 
+// ExactItemMatcher matches item IDs exactly and case-sensitively
+// The only normalization is the default "minecraft" namespace, so "diamond" matches
+// "minecraft:diamond" but "DIAMOND" does not. Network IDs (335 matches item:335) are still
+// accepted; aliases are not.
+func ExactItemMatcher(actualID, expectedID string) bool {
+	if strings.HasPrefix(actualID, "item:") && strings.TrimPrefix(actualID, "item:") == expectedID {
+		return true
+	}
+	return withDefaultNamespace(actualID) == withDefaultNamespace(expectedID)
+}
+
+// withDefaultNamespace adds the "minecraft" namespace to an item ID that has none
+func withDefaultNamespace(id string) string {
+	if id != "" && !strings.Contains(id, ":") {
+		return "minecraft:" + id
+	}
+	return id
+}
+
+// NormalizeItemID lowercases an item ID and adds the "minecraft" namespace if it has none
+func NormalizeItemID(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if id != "" && !strings.Contains(id, ":") {
+		id = "minecraft:" + id
+	}
+	return id
+}
+
 // getInventoryItemIDs returns a list of item IDs in the inventory
 func getInventoryItemIDs(items []types.InventoryItem) []string {
 	ids := make([]string, 0, len(items))
@@ -0,0 +1,41 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+)
+
+// PacketAssertion provides assertions on raw packet counts for protocol-level tests
+// Counts include every packet with the ID received since connecting; compare against
+// agent.PacketCount taken before an action to check what the action caused.
+type PacketAssertion struct {
+	agent AgentInterface
+}
+
+// ToReceivePacket waits until at least minCount packets with packetID have been received
+// packetID is one of the packet.ID* constants, e.g. packet.IDSetScore.
+func (p *PacketAssertion) ToReceivePacket(packetID uint32, minCount int, timeout time.Duration) {
+	check := func() bool { return p.agent.PacketCount(packetID) >= minCount }
+	if !waitUntil(p.agent.Emitter(), []events.EventName{events.EventPacket}, check, timeout) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for %d packet(s) with ID %d within %v", minCount, packetID, timeout),
+			fmt.Sprintf(">= %d", minCount),
+			p.agent.PacketCount(packetID),
+		))
+	}
+}
+
+// ToHaveReceivedExactly checks that exactly count packets with packetID have been received
+func (p *PacketAssertion) ToHaveReceivedExactly(packetID uint32, count int) {
+	actual := p.agent.PacketCount(packetID)
+
+	if actual != count {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected %d packet(s) with ID %d", count, packetID),
+			count,
+			actual,
+		))
+	}
+}
@@ -0,0 +1,73 @@
+package assertions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// ParticleAssertion provides assertions on particles spawned near the agent
+// Particle names are matched like sound names (see matchesSoundName), ignoring a "_particle"
+// suffix: "heart" matches "minecraft:heart_particle".
+type ParticleAssertion struct {
+	agent AgentInterface
+}
+
+// ToSpawn waits for a particle matching name within the timeout and returns it
+// Only particles spawned after the call count.
+func (p *ParticleAssertion) ToSpawn(name string, timeout time.Duration) *types.ParticleSpawn {
+	particle, err := p.waitForParticle(timeout, func(particle *types.ParticleSpawn) bool {
+		return matchesParticleName(particle.Name, name)
+	})
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for particle %q within %v", name, timeout),
+			name,
+			nil,
+		))
+	}
+	return particle
+}
+
+// ToSpawnAt waits for a particle matching name spawned within tolerance blocks of pos and returns it
+func (p *ParticleAssertion) ToSpawnAt(name string, pos types.Position, tolerance float64, timeout time.Duration) *types.ParticleSpawn {
+	particle, err := p.waitForParticle(timeout, func(particle *types.ParticleSpawn) bool {
+		return matchesParticleName(particle.Name, name) && distanceTo(particle.Position, pos) <= tolerance
+	})
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for particle %q within %.2f of (%.2f, %.2f, %.2f) within %v",
+				name, tolerance, pos.X, pos.Y, pos.Z, timeout),
+			name,
+			nil,
+		))
+	}
+	return particle
+}
+
+// waitForParticle waits for a particle event accepted by match
+func (p *ParticleAssertion) waitForParticle(timeout time.Duration, match func(*types.ParticleSpawn) bool) (*types.ParticleSpawn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	data, err := p.agent.Emitter().WaitFor(ctx, events.EventParticle, func(d events.EventData) bool {
+		particle, ok := d.(*types.ParticleSpawn)
+		return ok && match(particle)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.ParticleSpawn), nil
+}
+
+// matchesParticleName reports whether a particle name matches the expected name
+func matchesParticleName(actual, expected string) bool {
+	trim := func(name string) string {
+		return strings.TrimSuffix(strings.ToLower(name), "_particle")
+	}
+	return matchesSoundName(trim(actual), trim(expected))
+}
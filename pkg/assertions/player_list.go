@@ -0,0 +1,149 @@
+package assertions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// PlayerListAssertion provides assertions on the server's player list
+type PlayerListAssertion struct {
+	agent AgentInterface
+}
+
+// ToContain checks that a player with the given name is in the player list
+func (p *PlayerListAssertion) ToContain(name string) {
+	if !p.contains(name) {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected player list to contain %q", name),
+			name,
+			playerNames(p.agent.GetPlayerList()),
+		))
+	}
+}
+
+// ToInclude checks that a player with the given name is online now or joins within the timeout
+func (p *PlayerListAssertion) ToInclude(name string, timeout time.Duration) *types.PlayerListEntry {
+	return p.ToSeeJoin(name, timeout)
+}
+
+// ToHaveCount checks that exactly n players are online, counting the agent itself
+func (p *PlayerListAssertion) ToHaveCount(n int) {
+	players := pollState(p.agent.GetPlayerList, func(players []types.PlayerListEntry) bool {
+		return len(players) == n
+	})
+
+	if len(players) != n {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected %d online player(s), but was %d", n, len(players)),
+			n,
+			playerNames(players),
+		))
+	}
+}
+
+// ToHaveDisplayName checks that the server lists the agent itself under the given name
+func (p *PlayerListAssertion) ToHaveDisplayName(name string) {
+	if actual := p.agent.DisplayName(); actual != name {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected agent to be listed as %q, but was %q", name, actual),
+			name,
+			actual,
+		))
+	}
+}
+
+// ToSeeJoin waits until a player with the given name joins
+// It passes immediately if the player is already in the player list, so it can be used right
+// after another agent's Connect without racing against the join.
+func (p *PlayerListAssertion) ToSeeJoin(name string, timeout time.Duration) *types.PlayerListEntry {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Subscribe before checking the current list so a join in between is not missed
+	joined := make(chan *types.PlayerListEntry, 1)
+	listenerID := p.agent.Emitter().On(events.EventPlayerJoin, func(data events.EventData) {
+		entry, ok := data.(*types.PlayerListEntry)
+		if !ok || entry.Name != name {
+			return
+		}
+		select {
+		case joined <- entry:
+		default:
+		}
+	})
+	defer p.agent.Emitter().Off(events.EventPlayerJoin, listenerID)
+
+	for _, entry := range p.agent.GetPlayerList() {
+		if entry.Name == name {
+			return &entry
+		}
+	}
+
+	select {
+	case entry := <-joined:
+		return entry
+	case <-ctx.Done():
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for player %q to join", name),
+			name,
+			playerNames(p.agent.GetPlayerList()),
+		))
+	}
+}
+
+// ToSeeLeave waits until a player with the given name leaves
+// It passes immediately if the player is not in the player list.
+func (p *PlayerListAssertion) ToSeeLeave(name string, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	left := make(chan struct{}, 1)
+	listenerID := p.agent.Emitter().On(events.EventPlayerLeave, func(data events.EventData) {
+		entry, ok := data.(*types.PlayerListEntry)
+		if !ok || entry.Name != name {
+			return
+		}
+		select {
+		case left <- struct{}{}:
+		default:
+		}
+	})
+	defer p.agent.Emitter().Off(events.EventPlayerLeave, listenerID)
+
+	if !p.contains(name) {
+		return
+	}
+
+	select {
+	case <-left:
+	case <-ctx.Done():
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for player %q to leave", name),
+			fmt.Sprintf("%q not in player list", name),
+			playerNames(p.agent.GetPlayerList()),
+		))
+	}
+}
+
+// contains reports whether a player with the given name is in the player list
+func (p *PlayerListAssertion) contains(name string) bool {
+	for _, entry := range p.agent.GetPlayerList() {
+		if entry.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// playerNames returns the names of the given player list entries
+func playerNames(entries []types.PlayerListEntry) []string {
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name
+	}
+	return names
+}
@@ -135,6 +135,24 @@ func (p *PositionAssertion) ToReach(ctx context.Context, expected types.Position
 	}
 }
 
+// ToBeCorrected waits for the server to correct (pull back) the player's movement
+// Corrections are MovePlayer packets in reset mode or CorrectPlayerMovePrediction packets
+func (p *PositionAssertion) ToBeCorrected(timeout time.Duration) *types.MoveCorrection {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	data, err := p.agent.Emitter().WaitFor(ctx, events.EventMoveCorrected, nil)
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Expected movement to be corrected by the server within %v", timeout),
+			"movement correction",
+			"no correction",
+		))
+	}
+
+	return data.(*types.MoveCorrection)
+}
+
 // ToBeInDimension asserts that the player is in the specified dimension
 func (p *PositionAssertion) ToBeInDimension(dimension string) {
 	actual := p.agent.State().Dimension
@@ -0,0 +1,90 @@
+package assertions
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+)
+
+// DefaultStateRetryInterval is how often state assertions re-read the agent state while retrying
+const DefaultStateRetryInterval = 50 * time.Millisecond
+
+var (
+	stateRetryTimeout  atomic.Int64 // time.Duration; 0 = fail on the first check
+	stateRetryInterval atomic.Int64 // time.Duration
+)
+
+func init() {
+	stateRetryInterval.Store(int64(DefaultStateRetryInterval))
+}
+
+// SetStateRetry makes state assertions (health, hunger, experience, inventory, held item, time, player count ToBe*/ToHave*) keep re-checking
+// the agent state for up to timeout before failing, polling every interval
+// This covers the first attribute and inventory sync after spawning, which otherwise has to be
+// waited for explicitly. A timeout of 0 disables retrying (the default); an interval of 0 uses
+// DefaultStateRetryInterval. Passing assertions return as soon as the state matches.
+func SetStateRetry(timeout, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultStateRetryInterval
+	}
+	stateRetryTimeout.Store(int64(timeout))
+	stateRetryInterval.Store(int64(interval))
+}
+
+// StateRetry returns the retry timeout and poll interval set with SetStateRetry
+func StateRetry() (timeout, interval time.Duration) {
+	return time.Duration(stateRetryTimeout.Load()), time.Duration(stateRetryInterval.Load())
+}
+
+// pollState reads a state value until ok accepts it or the state retry timeout passes
+// It returns the last value read, so failing assertions report the final state.
+func pollState[T any](get func() T, ok func(T) bool) T {
+	value := get()
+	timeout, interval := StateRetry()
+	if ok(value) || timeout <= 0 {
+		return value
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+		if value = get(); ok(value) {
+			return value
+		}
+	}
+	return value
+}
+
+// waitUntil re-checks check whenever one of the events is emitted until it passes or timeout passes
+// The listeners are registered before the first check, so an event landing in between is not missed.
+// They are asynchronous, so the agent's synchronous state listeners have run before each re-check.
+func waitUntil(emitter *events.Emitter, names []events.EventName, check func() bool, timeout time.Duration) bool {
+	signal := make(chan struct{}, 1)
+	for _, name := range names {
+		id := emitter.On(name, func(events.EventData) {
+			select {
+			case signal <- struct{}{}:
+			default:
+			}
+		})
+		defer emitter.Off(name, id)
+	}
+
+	if check() {
+		return true
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		select {
+		case <-signal:
+			if check() {
+				return true
+			}
+		case <-deadline.C:
+			return check()
+		}
+	}
+}
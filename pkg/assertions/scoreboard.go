@@ -301,6 +301,57 @@ func (s *ScoreboardAssertion) ToHavePlayerScore(objectiveName string, entityID i
 	}
 }
 
+// ToHaveMyScore waits for the agent's own score in an objective to be a specific value
+// The agent is identified by its RuntimeEntityID, the same key Agent.GetScore uses
+func (s *ScoreboardAssertion) ToHaveMyScore(objectiveName string, expectedScore int32, timeout time.Duration) {
+	check := func() bool {
+		score := s.agent.GetScore(objectiveName)
+		return score != nil && *score == expectedScore
+	}
+	if !waitUntil(s.agent.Emitter(), []events.EventName{events.EventScoreUpdate}, check, timeout) {
+		var actual interface{} = "none"
+		if score := s.agent.GetScore(objectiveName); score != nil {
+			actual = *score
+		}
+		panic(NewAssertionError(
+			fmt.Sprintf("expected own score in objective %q to be %d within %v", objectiveName, expectedScore, timeout),
+			expectedScore,
+			actual,
+		))
+	}
+}
+
+// ToIncreaseBy records the agent's current score in an objective and waits for it to change by delta
+// A missing score counts as 0, so the first score set for the agent is compared against 0.
+// The score is re-read on every update, so a change landing right after the baseline is recorded is not missed.
+func (s *ScoreboardAssertion) ToIncreaseBy(objectiveName string, delta int32, timeout time.Duration) {
+	current := func() int32 {
+		if score := s.agent.GetScore(objectiveName); score != nil {
+			return *score
+		}
+		return 0
+	}
+
+	before := current()
+	check := func() bool { return current()-before == delta }
+	if !waitUntil(s.agent.Emitter(), []events.EventName{events.EventScoreUpdate}, check, timeout) {
+		var actual interface{} = "no change"
+		if changed := current() - before; changed != 0 {
+			actual = changed
+		}
+		panic(NewAssertionError(
+			fmt.Sprintf("expected own score in objective %q to change by %d from %d within %v", objectiveName, delta, before, timeout),
+			delta,
+			actual,
+		))
+	}
+}
+
+// ToDecreaseBy records the agent's current score in an objective and waits for it to decrease by delta
+func (s *ScoreboardAssertion) ToDecreaseBy(objectiveName string, delta int32, timeout time.Duration) {
+	s.ToIncreaseBy(objectiveName, -delta, timeout)
+}
+
 // ToHaveFakePlayerScore waits for a fake player (by display name) to have a specific score
 func (s *ScoreboardAssertion) ToHaveFakePlayerScore(objectiveName string, displayName string, expectedScore int32, timeout time.Duration) {
 	// First check current state
@@ -400,3 +451,33 @@ func (s *ScoreboardAssertion) ToHaveDisplaySlot(objectiveName string, displaySlo
 		panic(fmt.Errorf("objective %q not displayed in slot %q within %v: %w", objectiveName, displaySlot, timeout, err))
 	}
 }
+
+// ToHaveObjectiveDisplayName waits for an objective to be displayed with a specific display name
+// The display name is the rendered header text (e.g. the sidebar title), not the internal objective name
+func (s *ScoreboardAssertion) ToHaveObjectiveDisplayName(objectiveName string, expectedDisplayName string, timeout time.Duration) {
+	check := func() bool {
+		displayName, ok := s.objectiveDisplayName(objectiveName)
+		return ok && displayName == expectedDisplayName
+	}
+	if !waitUntil(s.agent.Emitter(), []events.EventName{events.EventScoreUpdate}, check, timeout) {
+		actual, _ := s.objectiveDisplayName(objectiveName)
+		panic(NewAssertionError(
+			fmt.Sprintf("expected objective %q display name to be %q within %v", objectiveName, expectedDisplayName, timeout),
+			expectedDisplayName,
+			actual,
+		))
+	}
+}
+
+// objectiveDisplayName returns the display name of a displayed objective
+func (s *ScoreboardAssertion) objectiveDisplayName(objectiveName string) (string, bool) {
+	sb := s.agent.State().Scoreboard
+	if sb == nil {
+		return "", false
+	}
+	obj, exists := sb.Objectives[objectiveName]
+	if !exists {
+		return "", false
+	}
+	return obj.DisplayName, true
+}
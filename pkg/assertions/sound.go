@@ -0,0 +1,104 @@
+package assertions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// SoundAssertion provides assertions on sounds played to the agent
+// Sounds sent with PlaySound keep their sound name (e.g. "random.levelup"); level sound events
+// are named after the event (e.g. "level_up"). Names are matched with matchesSoundName, so either
+// form finds both.
+type SoundAssertion struct {
+	agent AgentInterface
+}
+
+// ToPlay waits for a sound with the given name within the timeout and returns it
+// Only sounds played after the call count.
+func (s *SoundAssertion) ToPlay(name string, timeout time.Duration) *types.SoundPlay {
+	sound, err := s.waitForSound(timeout, func(sound *types.SoundPlay) bool {
+		return matchesSoundName(sound.Name, name)
+	})
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for sound %q within %v", name, timeout),
+			name,
+			nil,
+		))
+	}
+	return sound
+}
+
+// ToPlayNear waits for a sound with the given name played within radius blocks of pos and returns it
+func (s *SoundAssertion) ToPlayNear(name string, pos types.Position, radius float64, timeout time.Duration) *types.SoundPlay {
+	sound, err := s.waitForSound(timeout, func(sound *types.SoundPlay) bool {
+		return matchesSoundName(sound.Name, name) && distanceTo(sound.Position, pos) <= radius
+	})
+	if err != nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for sound %q within %.2f of (%.2f, %.2f, %.2f) within %v",
+				name, radius, pos.X, pos.Y, pos.Z, timeout),
+			name,
+			nil,
+		))
+	}
+	return sound
+}
+
+// NotToPlay checks that no sound with the given name is played within the duration
+func (s *SoundAssertion) NotToPlay(name string, duration time.Duration) {
+	sound, err := s.waitForSound(duration, func(sound *types.SoundPlay) bool {
+		return matchesSoundName(sound.Name, name)
+	})
+	if err == nil {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected sound %q not to play within %v", name, duration),
+			fmt.Sprintf("not %q", name),
+			sound.Name,
+		))
+	}
+}
+
+// waitForSound waits for a sound event accepted by match
+func (s *SoundAssertion) waitForSound(timeout time.Duration, match func(*types.SoundPlay) bool) (*types.SoundPlay, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	data, err := s.agent.Emitter().WaitFor(ctx, events.EventSound, func(d events.EventData) bool {
+		sound, ok := d.(*types.SoundPlay)
+		return ok && match(sound)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.SoundPlay), nil
+}
+
+// matchesSoundName reports whether a sound or particle name matches the expected name
+// Both are compared case-insensitively without namespace and underscores, so "level_up" equals
+// "levelup". An expected name without dots also matches the last dot-separated part of the
+// actual name, so "level_up" matches the PlaySound name "random.levelup".
+func matchesSoundName(actual, expected string) bool {
+	actualKey, expectedKey := soundNameKey(actual), soundNameKey(expected)
+	if actualKey == expectedKey {
+		return true
+	}
+	if strings.Contains(expectedKey, ".") {
+		return false
+	}
+	return actualKey[strings.LastIndex(actualKey, ".")+1:] == expectedKey
+}
+
+// soundNameKey normalizes a sound or particle name for matchesSoundName
+func soundNameKey(name string) string {
+	name = strings.ToLower(name)
+	if i := strings.Index(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ReplaceAll(name, "_", "")
+}
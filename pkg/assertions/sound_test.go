@@ -0,0 +1,48 @@
+package assertions
+
+import "testing"
+
+func TestMatchesSoundName(t *testing.T) {
+	tests := []struct {
+		actual   string
+		expected string
+		want     bool
+	}{
+		{"random.levelup", "random.levelup", true},
+		{"random.levelup", "RANDOM.LEVELUP", true},
+		{"random.levelup", "level_up", true},
+		{"level_up", "levelup", true},
+		{"level_up", "random.levelup", false},
+		{"minecraft:level_up", "level_up", true},
+		{"mob.zombie.say", "mob.skeleton.say", false},
+		{"random.click", "level_up", false},
+		{"note.harp", "note.bass", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesSoundName(tt.actual, tt.expected); got != tt.want {
+			t.Errorf("matchesSoundName(%q, %q) = %v, want %v", tt.actual, tt.expected, got, tt.want)
+		}
+	}
+}
+
+func TestMatchesParticleName(t *testing.T) {
+	tests := []struct {
+		actual   string
+		expected string
+		want     bool
+	}{
+		{"minecraft:heart_particle", "heart", true},
+		{"minecraft:heart_particle", "minecraft:heart_particle", true},
+		{"minecraft:heart_particle", "minecraft:heart", true},
+		{"minecraft:villager_happy", "villagerhappy", true},
+		{"minecraft:heart_particle", "heartbeat", false},
+		{"minecraft:basic_flame_particle", "flame", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesParticleName(tt.actual, tt.expected); got != tt.want {
+			t.Errorf("matchesParticleName(%q, %q) = %v, want %v", tt.actual, tt.expected, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+// TimeAssertion provides world time assertions
+type TimeAssertion struct {
+	agent AgentInterface
+}
+
+// time returns the current world time in ticks
+func (t *TimeAssertion) time() int32 {
+	return t.agent.State().Time
+}
+
+// ToBeNight checks that it is night (time of day 13000-22999)
+func (t *TimeAssertion) ToBeNight() {
+	actual := pollState(t.time, types.IsNight)
+
+	if !types.IsNight(actual) {
+		panic(NewAssertionError(
+			"expected it to be night",
+			fmt.Sprintf("time of day in %d-%d", types.NightStart, types.NightEnd-1),
+			types.TimeOfDay(actual),
+		))
+	}
+}
+
+// ToBeDay checks that it is not night
+func (t *TimeAssertion) ToBeDay() {
+	isDay := func(time int32) bool { return !types.IsNight(time) }
+	actual := pollState(t.time, isDay)
+
+	if !isDay(actual) {
+		panic(NewAssertionError(
+			"expected it to be day",
+			fmt.Sprintf("time of day outside %d-%d", types.NightStart, types.NightEnd-1),
+			types.TimeOfDay(actual),
+		))
+	}
+}
@@ -3,6 +3,7 @@ package assertions
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,6 +28,11 @@ func (t *TitleAssertion) ToContain(text string, timeout time.Duration) {
 	containDisplayType(t.agent, "title", text, timeout)
 }
 
+// ToHaveSeen checks that the title was shown, looking it up in the agent's title history
+func (t *TitleAssertion) ToHaveSeen(text string, timeout time.Duration) {
+	seenDisplayType(t.agent, "title", text, timeout)
+}
+
 // SubtitleAssertion provides subtitle-related assertions
 type SubtitleAssertion struct {
 	agent AgentInterface
@@ -44,6 +50,11 @@ func (s *SubtitleAssertion) ToContain(text string, timeout time.Duration) {
 	containDisplayType(s.agent, "subtitle", text, timeout)
 }
 
+// ToHaveSeen checks that the subtitle was shown, looking it up in the agent's title history
+func (s *SubtitleAssertion) ToHaveSeen(text string, timeout time.Duration) {
+	seenDisplayType(s.agent, "subtitle", text, timeout)
+}
+
 // ActionbarAssertion provides actionbar-related assertions
 type ActionbarAssertion struct {
 	agent AgentInterface
@@ -61,6 +72,34 @@ func (a *ActionbarAssertion) ToContain(text string, timeout time.Duration) {
 	containDisplayType(a.agent, "actionbar", text, timeout)
 }
 
+// ToHaveSeen checks that the actionbar text was shown, looking it up in the agent's title history
+func (a *ActionbarAssertion) ToHaveSeen(text string, timeout time.Duration) {
+	seenDisplayType(a.agent, "actionbar", text, timeout)
+}
+
+// seenDisplayType checks the title history for a display of the given type with exactly text
+// Displays that have not arrived yet are waited for until timeout; a timeout of 0 checks the history once.
+func seenDisplayType(agent AgentInterface, displayType, text string, timeout time.Duration) {
+	var seen []string
+	ok := waitUntil(agent.Emitter(), []events.EventName{events.EventTitle}, func() bool {
+		seen = seen[:0]
+		for _, display := range agent.TitleHistory() {
+			if display.Type == displayType {
+				seen = append(seen, display.Text)
+			}
+		}
+		return slices.Contains(seen, text)
+	}, timeout)
+
+	if !ok {
+		panic(NewAssertionError(
+			fmt.Sprintf("expected %s %q to have been shown within %v", displayType, text, timeout),
+			text,
+			seen,
+		))
+	}
+}
+
 func receiveDisplayType(agent AgentInterface, displayType, expected string, timeout time.Duration) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
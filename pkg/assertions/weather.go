@@ -0,0 +1,39 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// WeatherAssertion provides weather assertions
+type WeatherAssertion struct {
+	agent AgentInterface
+}
+
+// ToBe waits for the weather ("clear", "rain" or "thunder") within the timeout
+// It passes immediately if the weather already matches.
+func (w *WeatherAssertion) ToBe(weather string, timeout time.Duration) {
+	weather = strings.ToLower(weather)
+	switch weather {
+	case types.WeatherClear, types.WeatherRain, types.WeatherThunder:
+	default:
+		panic(NewAssertionError(
+			fmt.Sprintf("unknown weather %q", weather),
+			fmt.Sprintf("%q, %q or %q", types.WeatherClear, types.WeatherRain, types.WeatherThunder),
+			weather,
+		))
+	}
+
+	check := func() bool { return w.agent.State().Weather == weather }
+	if !waitUntil(w.agent.Emitter(), []events.EventName{events.EventWeatherUpdate}, check, timeout) {
+		panic(NewAssertionError(
+			fmt.Sprintf("Timeout waiting for weather %q within %v", weather, timeout),
+			weather,
+			w.agent.State().Weather,
+		))
+	}
+}
@@ -0,0 +1,367 @@
+package besttest
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/sandertv/gophertunnel/minecraft"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	bestprotocol "github.com/gollilla/best/pkg/protocol"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// CommandHandler handles a command sent by a player to the FakeServer
+// The command has no leading slash. The returned output is sent back as CommandOutput.
+type CommandHandler func(player string, command string) (output string, success bool)
+
+// FakeServer is an in-memory Bedrock server for integration tests
+// It accepts agents through gophertunnel's listener, answers commands and pushes
+// titles, scores and inventory contents on demand.
+type FakeServer struct {
+	listener *minecraft.Listener
+
+	mu             sync.Mutex
+	players        map[string]*fakePlayer
+	commandHandler CommandHandler
+	nextEntityID   int64
+	wg             sync.WaitGroup
+}
+
+// fakePlayer is a player connected to the FakeServer
+type fakePlayer struct {
+	conn         *minecraft.Conn
+	entityID     int64
+	transactions []protocol.InventoryTransactionData
+}
+
+// NewFakeServer starts a FakeServer listening on a random local port
+func NewFakeServer() (*FakeServer, error) {
+	listener, err := minecraft.ListenConfig{
+		AuthenticationDisabled: true,
+		StatusProvider:         minecraft.NewStatusProvider("Best Fake Server", "besttest"),
+	}.Listen("raknet", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	s := &FakeServer{
+		listener: listener,
+		players:  make(map[string]*fakePlayer),
+	}
+
+	s.wg.Add(1)
+	go s.acceptLoop()
+
+	return s, nil
+}
+
+// Host returns the host the server listens on
+func (s *FakeServer) Host() string {
+	host, _, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host
+}
+
+// Port returns the port the server listens on
+func (s *FakeServer) Port() uint16 {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	p, _ := strconv.ParseUint(port, 10, 16)
+	return uint16(p)
+}
+
+// OnCommand sets the handler used to answer player commands
+// Without a handler every command fails with "Unknown command". Commands sent with a
+// CommandRequest are answered with a CommandOutput packet, commands sent as chat with a chat message.
+func (s *FakeServer) OnCommand(handler CommandHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commandHandler = handler
+}
+
+// Players returns the names of the connected players
+func (s *FakeServer) Players() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.players))
+	for name := range s.players {
+		names = append(names, name)
+	}
+	return names
+}
+
+// WaitForPlayer waits until a player with the given name has spawned
+func (s *FakeServer) WaitForPlayer(name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		s.mu.Lock()
+		_, ok := s.players[name]
+		s.mu.Unlock()
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for player %s", name)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Transactions returns the InventoryTransaction data received from the named player, oldest first
+// Use this to check block, attack and use-item interactions without a real server.
+func (s *FakeServer) Transactions(player string) []protocol.InventoryTransactionData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[player]
+	if !ok {
+		return nil
+	}
+	return append([]protocol.InventoryTransactionData(nil), p.transactions...)
+}
+
+// SendPacket sends a packet to the named player
+func (s *FakeServer) SendPacket(player string, pk packet.Packet) error {
+	p, err := s.player(player)
+	if err != nil {
+		return err
+	}
+	return p.conn.WritePacket(pk)
+}
+
+// Broadcast sends a packet to every connected player
+func (s *FakeServer) Broadcast(pk packet.Packet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.players {
+		_ = p.conn.WritePacket(pk)
+	}
+}
+
+// SendTitle shows a title to the named player
+func (s *FakeServer) SendTitle(player string, text string) error {
+	return s.SendPacket(player, &packet.SetTitle{
+		ActionType:      packet.TitleActionSetTitle,
+		Text:            text,
+		FadeInDuration:  10,
+		RemainDuration:  70,
+		FadeOutDuration: 20,
+	})
+}
+
+// SendMessage sends a raw chat message to the named player
+func (s *FakeServer) SendMessage(player string, message string) error {
+	return s.SendPacket(player, &packet.Text{
+		TextType: packet.TextTypeRaw,
+		Message:  message,
+	})
+}
+
+// SetScore displays the objective in the sidebar and sets the named player's score
+func (s *FakeServer) SetScore(player string, objective string, score int32) error {
+	p, err := s.player(player)
+	if err != nil {
+		return err
+	}
+
+	if err := p.conn.WritePacket(&packet.SetDisplayObjective{
+		DisplaySlot:   packet.ScoreboardSlotSidebar,
+		ObjectiveName: objective,
+		DisplayName:   objective,
+		CriteriaName:  "dummy",
+		SortOrder:     packet.ScoreboardSortOrderDescending,
+	}); err != nil {
+		return err
+	}
+
+	return p.conn.WritePacket(&packet.SetScore{
+		ActionType: packet.ScoreboardActionModify,
+		Entries: []protocol.ScoreboardEntry{{
+			EntryID:        p.entityID,
+			ObjectiveName:  objective,
+			Score:          score,
+			IdentityType:   protocol.ScoreboardIdentityPlayer,
+			EntityUniqueID: p.entityID,
+		}},
+	})
+}
+
+// SetInventory replaces the named player's inventory contents
+// Item IDs use the same names as types.InventoryItem (e.g. "minecraft:diamond").
+func (s *FakeServer) SetInventory(player string, items []types.InventoryItem) error {
+	content := make([]protocol.ItemInstance, 36)
+	for _, item := range items {
+		if item.Slot < 0 || int(item.Slot) >= len(content) {
+			return fmt.Errorf("slot %d out of range", item.Slot)
+		}
+		networkID := bestprotocol.GetNetworkID(item.ID)
+		if networkID == 0 {
+			return fmt.Errorf("unknown item: %s", item.ID)
+		}
+		content[item.Slot] = protocol.ItemInstance{
+			StackNetworkID: 1,
+			Stack: protocol.ItemStack{
+				ItemType:     protocol.ItemType{NetworkID: networkID},
+				Count:        uint16(item.Count),
+				HasNetworkID: true,
+			},
+		}
+	}
+
+	return s.SendPacket(player, &packet.InventoryContent{
+		WindowID: protocol.WindowIDInventory,
+		Content:  content,
+		Container: protocol.FullContainerName{
+			ContainerID: protocol.ContainerCombinedHotBarAndInventory,
+		},
+	})
+}
+
+// Close disconnects all players and stops the server
+func (s *FakeServer) Close() error {
+	err := s.listener.Close()
+
+	s.mu.Lock()
+	for _, p := range s.players {
+		_ = p.conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+// player returns the connected player with the given name
+func (s *FakeServer) player(name string) (*fakePlayer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[name]
+	if !ok {
+		return nil, fmt.Errorf("player %s is not connected", name)
+	}
+	return p, nil
+}
+
+// acceptLoop accepts incoming connections until the listener is closed
+func (s *FakeServer) acceptLoop() {
+	defer s.wg.Done()
+
+	for {
+		c, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go s.handleConn(c.(*minecraft.Conn))
+	}
+}
+
+// handleConn spawns the player and serves its packets until it disconnects
+func (s *FakeServer) handleConn(conn *minecraft.Conn) {
+	defer s.wg.Done()
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.nextEntityID++
+	entityID := s.nextEntityID
+	s.mu.Unlock()
+
+	if err := conn.StartGame(minecraft.GameData{
+		WorldName:         "besttest",
+		EntityUniqueID:    entityID,
+		EntityRuntimeID:   uint64(entityID),
+		PlayerGameMode:    0,
+		PlayerPosition:    mgl32.Vec3{0, 64, 0},
+		WorldSpawn:        protocol.BlockPos{0, 64, 0},
+		WorldGameMode:     0,
+		PlayerPermissions: 1,
+		ChunkRadius:       4,
+	}); err != nil {
+		return
+	}
+
+	name := conn.IdentityData().DisplayName
+	player := &fakePlayer{conn: conn, entityID: entityID}
+	s.mu.Lock()
+	s.players[name] = player
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		// A reconnected player may already have replaced this connection
+		if s.players[name] == player {
+			delete(s.players, name)
+		}
+		s.mu.Unlock()
+	}()
+
+	for {
+		pk, err := conn.ReadPacket()
+		if err != nil {
+			return
+		}
+
+		switch p := pk.(type) {
+		case *packet.CommandRequest:
+			output, success := s.runCommand(name, p.CommandLine)
+			s.writeCommandOutput(conn, p.CommandOrigin, output, success)
+		case *packet.Text:
+			// Some servers accept commands sent as chat messages and answer them in chat
+			if strings.HasPrefix(p.Message, "/") {
+				output, success := s.runCommand(name, p.Message)
+				s.writeCommandMessage(conn, output, success)
+			}
+		case *packet.InventoryTransaction:
+			s.mu.Lock()
+			player.transactions = append(player.transactions, p.TransactionData)
+			s.mu.Unlock()
+		}
+	}
+}
+
+// runCommand runs a command line through the command handler
+func (s *FakeServer) runCommand(player string, commandLine string) (string, bool) {
+	s.mu.Lock()
+	handler := s.commandHandler
+	s.mu.Unlock()
+
+	if handler == nil {
+		return "Unknown command", false
+	}
+	return handler(player, strings.TrimPrefix(commandLine, "/"))
+}
+
+// writeCommandOutput answers a CommandRequest with a CommandOutput packet
+// The request's origin, including its UUID and request ID, is echoed so the client can match the output.
+func (s *FakeServer) writeCommandOutput(conn *minecraft.Conn, origin protocol.CommandOrigin, output string, success bool) {
+	var successCount uint32
+	if success {
+		successCount = 1
+	}
+
+	_ = conn.WritePacket(&packet.CommandOutput{
+		CommandOrigin: origin,
+		OutputType:    packet.CommandOutputTypeAllOutput,
+		SuccessCount:  successCount,
+		OutputMessages: []protocol.CommandOutputMessage{{
+			Success: success,
+			Message: output,
+		}},
+	})
+}
+
+// writeCommandMessage answers a command sent as chat with a raw chat message, red on failure
+func (s *FakeServer) writeCommandMessage(conn *minecraft.Conn, output string, success bool) {
+	if !success {
+		output = "§c" + output
+	}
+	_ = conn.WritePacket(&packet.Text{
+		TextType: packet.TextTypeRaw,
+		Message:  output,
+	})
+}
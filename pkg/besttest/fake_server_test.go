@@ -0,0 +1,177 @@
+package besttest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+
+	"github.com/gollilla/best/pkg/agent"
+	"github.com/gollilla/best/pkg/events"
+	bestprotocol "github.com/gollilla/best/pkg/protocol"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// newTestServer starts a FakeServer that is closed when the test ends
+func newTestServer(t *testing.T) *FakeServer {
+	t.Helper()
+	server, err := NewFakeServer()
+	if err != nil {
+		t.Fatalf("NewFakeServer: %v", err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	return server
+}
+
+// connectAgent connects an agent named name to server and waits until the server sees it
+func connectAgent(t *testing.T, server *FakeServer, name string, opts ...agent.AgentOption) *agent.Agent {
+	t.Helper()
+	a := agent.NewAgent(append([]agent.AgentOption{
+		agent.WithHost(server.Host()),
+		agent.WithPort(server.Port()),
+		agent.WithUsername(name),
+		agent.WithSpawnTimeout(10 * time.Second),
+	}, opts...)...)
+	if err := a.Connect(); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(func() { _ = a.Disconnect() })
+	if err := server.WaitForPlayer(name, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestFakeServerReconnectReceivesPackets(t *testing.T) {
+	server := newTestServer(t)
+	a := connectAgent(t, server, "Bot")
+
+	if err := a.Reconnect(agent.ReconnectOptions{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond}); err != nil {
+		t.Fatalf("Reconnect: %v", err)
+	}
+	if err := server.WaitForPlayer("Bot", 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	// The server may still hold the old connection for a moment, so resend until the message arrives
+	received := make(chan struct{})
+	a.Emitter().OnSync(events.EventChat, func(data events.EventData) {
+		if msg, ok := data.(*types.ChatMessage); ok && msg.Message == "after reconnect" {
+			select {
+			case <-received:
+			default:
+				close(received)
+			}
+		}
+	})
+
+	deadline := time.After(5 * time.Second)
+	for {
+		_ = server.SendMessage("Bot", "after reconnect")
+		select {
+		case <-received:
+			return
+		case <-deadline:
+			t.Fatal("no chat message received after reconnecting")
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+func TestFakeServerCommandWithResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		command string
+		want    string
+		success bool
+	}{
+		{"text", "text", "/ping", "pong", true},
+		{"request", "request", "/ping", "pong", true},
+		{"text unknown", "text", "/nope", "Unknown command", false},
+		{"request unknown", "request", "/nope", "Unknown command", false},
+	}
+
+	server := newTestServer(t)
+	server.OnCommand(func(player, command string) (string, bool) {
+		if command == "ping" {
+			return "pong", true
+		}
+		return "Unknown command", false
+	})
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := connectAgent(t, server, fmt.Sprintf("Bot%d", i),
+				agent.WithCommandSendMethod(tt.method),
+				agent.WithCommandTimeout(3*time.Second),
+			)
+
+			output, err := a.CommandWithResponse(tt.command)
+			if err != nil {
+				t.Fatalf("CommandWithResponse(%q): %v", tt.command, err)
+			}
+			if output.Success != tt.success {
+				t.Errorf("Success = %v, want %v", output.Success, tt.success)
+			}
+			if !strings.Contains(output.Output, tt.want) {
+				t.Errorf("Output = %q, want it to contain %q", output.Output, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakeServerUseItemSendsHeldItem(t *testing.T) {
+	server := newTestServer(t)
+	a := connectAgent(t, server, "Bot")
+
+	if err := server.SetInventory("Bot", []types.InventoryItem{{ID: "minecraft:dirt", Count: 5, Slot: 2}}); err != nil {
+		t.Fatalf("SetInventory: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !hasItemInSlot(a, 2) {
+		if time.Now().After(deadline) {
+			t.Fatal("inventory not received")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if err := a.SelectSlot(2); err != nil {
+		t.Fatalf("SelectSlot: %v", err)
+	}
+	if err := a.UseItem(types.Position{X: 1, Y: 64, Z: 1}, types.BlockFaceUp); err != nil {
+		t.Fatalf("UseItem: %v", err)
+	}
+
+	var data *protocol.UseItemTransactionData
+	for data == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("no use-item transaction received")
+		}
+		for _, tx := range server.Transactions("Bot") {
+			if d, ok := tx.(*protocol.UseItemTransactionData); ok {
+				data = d
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if data.HotBarSlot != 2 {
+		t.Errorf("HotBarSlot = %d, want 2", data.HotBarSlot)
+	}
+	if want := bestprotocol.GetNetworkID("minecraft:dirt"); data.HeldItem.Stack.NetworkID != want || data.HeldItem.Stack.Count != 5 {
+		t.Errorf("HeldItem = %d x%d, want %d x5", data.HeldItem.Stack.NetworkID, data.HeldItem.Stack.Count, want)
+	}
+}
+
+// hasItemInSlot reports whether the agent's inventory holds an item in slot
+func hasItemInSlot(a *agent.Agent, slot int32) bool {
+	for _, item := range a.GetInventory() {
+		if item.Slot == slot && item.ID != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,609 @@
+// Package besttest provides helpers for testing code built on best without a live server
+package besttest
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gollilla/best/pkg/assertions"
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+	"github.com/gollilla/best/pkg/world"
+)
+
+// Ensure MockAgent satisfies the interface used by assertions
+var _ assertions.AgentInterface = (*MockAgent)(nil)
+
+// SubmittedForm records a form response passed to MockAgent.SubmitForm
+type SubmittedForm struct {
+	FormID   int32
+	Response types.FormResponse
+}
+
+// MockAgent is an in-memory AgentInterface implementation for unit testing assertions
+// State is set directly through the Set* methods and events are injected with Inject
+type MockAgent struct {
+	mu             sync.RWMutex
+	connected      bool
+	spawned        bool
+	state          types.PlayerState
+	inventory      []types.InventoryItem
+	effects        []types.Effect
+	entities       []types.Entity
+	tags           []string
+	playerList     []types.PlayerListEntry
+	bossBars       []types.BossBar
+	packetCounts   map[uint32]int
+	chatHistory    []types.ChatMessage
+	titleHistory   []types.TitleDisplay
+	displayName    string
+	hunger         float32
+	deaths         int
+	heldSlot       int32
+	pendingForms   map[int32]types.Form
+	submitted      []SubmittedForm
+	nextScoreEntry int64
+	world          *world.World
+	emitter        *events.Emitter
+}
+
+// NewMockAgent creates a connected and spawned mock agent with full health and hunger
+func NewMockAgent() *MockAgent {
+	m := &MockAgent{
+		connected: true,
+		spawned:   true,
+		state: types.PlayerState{
+			RuntimeEntityID: 1,
+			Health:          20,
+			MaxHealth:       20,
+			Weather:         types.WeatherClear,
+			Dimension:       "overworld",
+			Scoreboard: &types.ScoreboardState{
+				Objectives: make(map[string]*types.ScoreboardObjective),
+				Entries:    make(map[int64]*types.ScoreboardEntry),
+			},
+		},
+		hunger:       20,
+		pendingForms: make(map[int32]types.Form),
+		world:        world.NewWorld(),
+		emitter:      events.NewEmitter(),
+	}
+
+	// Apply injected block updates to the world, as Agent does
+	m.emitter.OnSync(events.EventBlockUpdate, func(data events.EventData) {
+		if update, ok := data.(*types.BlockUpdate); ok {
+			m.loadChunk(update.Position)
+			_ = m.world.SetBlockAt(update.Position, uint32(update.RuntimeID))
+		}
+	})
+
+	// Record injected chat messages and titles in the history, as Agent does
+	m.emitter.OnSync(events.EventChat, func(data events.EventData) {
+		if msg, ok := data.(*types.ChatMessage); ok {
+			m.mu.Lock()
+			m.chatHistory = append(m.chatHistory, *msg)
+			m.mu.Unlock()
+		}
+	})
+
+	m.emitter.OnSync(events.EventTitle, func(data events.EventData) {
+		if title, ok := data.(*types.TitleDisplay); ok {
+			m.mu.Lock()
+			m.titleHistory = append(m.titleHistory, *title)
+			m.mu.Unlock()
+		}
+	})
+
+	// Count injected deaths, as Agent does
+	m.emitter.OnSync(events.EventDeath, func(_ events.EventData) {
+		m.mu.Lock()
+		m.deaths++
+		m.mu.Unlock()
+	})
+
+	// Track injected hotbar selections, as Agent does
+	m.emitter.OnSync(events.EventHeldSlotUpdate, func(data events.EventData) {
+		if slot, ok := data.(int32); ok {
+			m.SetHeldSlot(slot)
+		}
+	})
+
+	return m
+}
+
+// Expect returns an assertion context for the mock agent
+func (m *MockAgent) Expect() *assertions.AssertionContext {
+	return assertions.NewAssertionContext(m)
+}
+
+// Inject emits a synthetic event on the mock agent's emitter
+func (m *MockAgent) Inject(event events.EventName, data events.EventData) {
+	m.emitter.Emit(event, data)
+}
+
+// InjectOnWait emits a synthetic event as soon as an assertion starts waiting for it
+// Waiting assertions only see events emitted after they start listening, so the event is held
+// back until a new listener for it is registered, or dropped after DefaultInjectWait. Unlike
+// InjectAfter no delay has to be guessed. Call it before the waiting assertion.
+func (m *MockAgent) InjectOnWait(event events.EventName, data events.EventData) {
+	listeners := m.emitter.ListenerCount(event)
+	go func() {
+		deadline := time.Now().Add(DefaultInjectWait)
+		for m.emitter.ListenerCount(event) <= listeners {
+			if time.Now().After(deadline) {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+		m.emitter.Emit(event, data)
+	}()
+}
+
+// DefaultInjectWait is how long InjectOnWait waits for an assertion to start listening
+const DefaultInjectWait = 5 * time.Second
+
+// InjectAfter emits a synthetic event after delay
+// Use this for waiting assertions, which only see events emitted after they start listening
+func (m *MockAgent) InjectAfter(delay time.Duration, event events.EventName, data events.EventData) {
+	go func() {
+		time.Sleep(delay)
+		m.emitter.Emit(event, data)
+	}()
+}
+
+// SetConnected sets the connection state
+func (m *MockAgent) SetConnected(connected bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.connected = connected
+}
+
+// SetSpawned sets the spawn state
+func (m *MockAgent) SetSpawned(spawned bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.spawned = spawned
+}
+
+// SetPosition sets the current position
+func (m *MockAgent) SetPosition(pos types.Position) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state.Position = pos
+}
+
+// SetHealth sets the current health
+func (m *MockAgent) SetHealth(health float32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state.Health = health
+}
+
+// SetMaxHealth sets the maximum of the health attribute
+func (m *MockAgent) SetMaxHealth(maxHealth float32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state.MaxHealth = maxHealth
+}
+
+// SetGamemode sets the current gamemode
+func (m *MockAgent) SetGamemode(gamemode int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state.Gamemode = gamemode
+}
+
+// SetPermissionLevel sets the current permission level
+func (m *MockAgent) SetPermissionLevel(level int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state.PermissionLevel = level
+}
+
+// SetHunger sets the current hunger
+func (m *MockAgent) SetHunger(hunger float32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hunger = hunger
+}
+
+// SetInventory replaces the inventory
+func (m *MockAgent) SetInventory(items []types.InventoryItem) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inventory = append([]types.InventoryItem(nil), items...)
+}
+
+// SetHeldSlot sets the selected hotbar slot
+func (m *MockAgent) SetHeldSlot(slot int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.heldSlot = slot
+}
+
+// SetEffects replaces the active effects
+func (m *MockAgent) SetEffects(effects []types.Effect) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.effects = append([]types.Effect(nil), effects...)
+}
+
+// SetEntities replaces the nearby entities
+func (m *MockAgent) SetEntities(entities []types.Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entities = append([]types.Entity(nil), entities...)
+}
+
+// SetTags replaces the player tags
+func (m *MockAgent) SetTags(tags []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tags = append([]string(nil), tags...)
+}
+
+// SetPlayerList replaces the player list
+// Use Inject with events.EventPlayerJoin/EventPlayerLeave to simulate changes for waiting assertions.
+func (m *MockAgent) SetPlayerList(entries []types.PlayerListEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.playerList = append([]types.PlayerListEntry(nil), entries...)
+}
+
+// SetBossBars replaces the shown boss bars
+// Use Inject with events.EventBossBar to simulate bars appearing for waiting assertions.
+func (m *MockAgent) SetBossBars(bars []types.BossBar) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bossBars = append([]types.BossBar(nil), bars...)
+}
+
+// SetPacketCount sets how many packets with the given ID the mock agent has received
+func (m *MockAgent) SetPacketCount(packetID uint32, count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.packetCounts == nil {
+		m.packetCounts = make(map[uint32]int)
+	}
+	m.packetCounts[packetID] = count
+}
+
+// SetDisplayName sets the name the server lists the mock agent under
+func (m *MockAgent) SetDisplayName(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.displayName = name
+}
+
+// SetBlock places a block and registers its runtime ID under name
+// Injected events.EventBlockUpdate events with a registered runtime ID resolve to that name.
+func (m *MockAgent) SetBlock(pos types.Position, name string, runtimeID uint32) {
+	m.world.Registry().Register(runtimeID, name)
+	m.loadChunk(pos)
+	_ = m.world.SetBlockAt(pos, runtimeID)
+}
+
+// loadChunk loads the chunk containing pos as an all-air chunk unless it is already loaded
+func (m *MockAgent) loadChunk(pos types.Position) {
+	chunkPos := world.ChunkPos{X: int32(math.Floor(pos.X)) >> 4, Z: int32(math.Floor(pos.Z)) >> 4}
+	if _, ok := m.world.GetChunk(chunkPos); !ok {
+		m.world.SetChunk(chunkPos, &world.Chunk{Position: chunkPos, Complete: true})
+	}
+}
+
+// SetOwnScore sets the mock agent's own score on an objective
+func (m *MockAgent) SetOwnScore(objectiveName string, score int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setScore(&types.ScoreboardEntry{
+		ObjectiveName:  objectiveName,
+		Score:          score,
+		IdentityType:   types.ScoreboardIdentityPlayer,
+		EntityUniqueID: m.state.RuntimeEntityID,
+	})
+}
+
+// SetScore sets the score of a fake player entry identified by display name
+func (m *MockAgent) SetScore(objectiveName string, displayName string, score int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setScore(&types.ScoreboardEntry{
+		ObjectiveName: objectiveName,
+		Score:         score,
+		IdentityType:  types.ScoreboardIdentityFakePlayer,
+		DisplayName:   displayName,
+	})
+}
+
+// setScore updates a matching entry or adds a new one
+func (m *MockAgent) setScore(entry *types.ScoreboardEntry) {
+	for _, existing := range m.state.Scoreboard.Entries {
+		if existing.ObjectiveName == entry.ObjectiveName &&
+			existing.EntityUniqueID == entry.EntityUniqueID &&
+			existing.DisplayName == entry.DisplayName {
+			existing.Score = entry.Score
+			return
+		}
+	}
+
+	m.nextScoreEntry++
+	entry.EntryID = m.nextScoreEntry
+	m.state.Scoreboard.Entries[entry.EntryID] = entry
+	if _, ok := m.state.Scoreboard.Objectives[entry.ObjectiveName]; !ok {
+		m.state.Scoreboard.Objectives[entry.ObjectiveName] = &types.ScoreboardObjective{
+			Name:        entry.ObjectiveName,
+			DisplayName: entry.ObjectiveName,
+		}
+	}
+}
+
+// AddForm stores a form as pending and emits EventForm, as if the server had sent it
+func (m *MockAgent) AddForm(form types.Form) {
+	m.mu.Lock()
+	m.pendingForms[form.GetID()] = form
+	m.mu.Unlock()
+
+	m.emitter.Emit(events.EventForm, form)
+}
+
+// SubmittedForms returns the form responses passed to SubmitForm
+func (m *MockAgent) SubmittedForms() []SubmittedForm {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]SubmittedForm(nil), m.submitted...)
+}
+
+// IsConnected returns whether the mock agent is connected
+func (m *MockAgent) IsConnected() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.connected
+}
+
+// IsSpawned returns whether the mock agent has spawned
+func (m *MockAgent) IsSpawned() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.spawned
+}
+
+// Position returns the current position
+func (m *MockAgent) Position() types.Position {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.Position
+}
+
+// State returns a copy of the current player state
+func (m *MockAgent) State() types.PlayerState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	state := m.state
+	state.Scoreboard = m.state.Scoreboard.Clone()
+	return state
+}
+
+// Health returns the current health
+func (m *MockAgent) Health() float32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.Health
+}
+
+// Gamemode returns the current gamemode
+func (m *MockAgent) Gamemode() int32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.Gamemode
+}
+
+// GetInventory returns a copy of the inventory
+func (m *MockAgent) GetInventory() []types.InventoryItem {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.InventoryItem(nil), m.inventory...)
+}
+
+// HeldItem returns the inventory item in the selected hotbar slot, or nil if it is empty
+func (m *MockAgent) HeldItem() *types.InventoryItem {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, item := range m.inventory {
+		if item.Slot == m.heldSlot && item.ID != "" {
+			return &item
+		}
+	}
+	return nil
+}
+
+// GetEffects returns a copy of active effects
+func (m *MockAgent) GetEffects() []types.Effect {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.Effect(nil), m.effects...)
+}
+
+// GetEntities returns a copy of nearby entities
+func (m *MockAgent) GetEntities() []types.Entity {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.Entity(nil), m.entities...)
+}
+
+// GetTags returns a copy of player tags
+func (m *MockAgent) GetTags() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]string(nil), m.tags...)
+}
+
+// DisplayName returns the name set with SetDisplayName
+func (m *MockAgent) DisplayName() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.displayName
+}
+
+// GetBlockAt returns the block placed with SetBlock or injected block updates
+func (m *MockAgent) GetBlockAt(pos types.Position) (*types.Block, bool) {
+	return m.world.GetBlockAt(pos)
+}
+
+// GetPlayerList returns a copy of the player list
+func (m *MockAgent) GetPlayerList() []types.PlayerListEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.PlayerListEntry(nil), m.playerList...)
+}
+
+// ChatHistory returns the chat messages injected so far, oldest first
+func (m *MockAgent) ChatHistory() []types.ChatMessage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.ChatMessage(nil), m.chatHistory...)
+}
+
+// TitleHistory returns the titles injected so far, oldest first
+func (m *MockAgent) TitleHistory() []types.TitleDisplay {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.TitleDisplay(nil), m.titleHistory...)
+}
+
+// PacketCount returns the packet count set with SetPacketCount
+func (m *MockAgent) PacketCount(packetID uint32) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.packetCounts[packetID]
+}
+
+// GetBossBars returns a copy of the shown boss bars
+func (m *MockAgent) GetBossBars() []types.BossBar {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]types.BossBar(nil), m.bossBars...)
+}
+
+// GetHunger returns the current hunger
+func (m *MockAgent) GetHunger() float32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.hunger
+}
+
+// GetDeathCount returns the number of injected EventDeath events
+func (m *MockAgent) GetDeathCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.deaths
+}
+
+// GetPermissionLevel returns the current permission level
+func (m *MockAgent) GetPermissionLevel() int32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state.PermissionLevel
+}
+
+// GetScore returns the mock agent's own score
+func (m *MockAgent) GetScore(objectiveName string) *int32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.findScore(func(e *types.ScoreboardEntry) bool {
+		return e.ObjectiveName == objectiveName && e.EntityUniqueID == m.state.RuntimeEntityID
+	})
+}
+
+// GetScoreByPlayer returns the score for a specific display name
+func (m *MockAgent) GetScoreByPlayer(objectiveName string, displayName string) *int32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.findScore(func(e *types.ScoreboardEntry) bool {
+		return e.ObjectiveName == objectiveName && e.DisplayName == displayName
+	})
+}
+
+// GetScoreByEntityID returns the score for a specific entity ID
+func (m *MockAgent) GetScoreByEntityID(objectiveName string, entityID int64) *int32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.findScore(func(e *types.ScoreboardEntry) bool {
+		return e.ObjectiveName == objectiveName && e.EntityUniqueID == entityID
+	})
+}
+
+// GetAllScores returns all score entries for the objective
+func (m *MockAgent) GetAllScores(objectiveName string) []types.ScoreboardEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	scores := []types.ScoreboardEntry{}
+	for _, entry := range m.state.Scoreboard.Entries {
+		if entry.ObjectiveName == objectiveName {
+			scores = append(scores, *entry)
+		}
+	}
+	return scores
+}
+
+// findScore returns the score of the first entry matching the filter
+func (m *MockAgent) findScore(match func(*types.ScoreboardEntry) bool) *int32 {
+	for _, entry := range m.state.Scoreboard.Entries {
+		if match(entry) {
+			score := entry.Score
+			return &score
+		}
+	}
+	return nil
+}
+
+// GetPendingForm returns a pending form by ID
+func (m *MockAgent) GetPendingForm(id int32) (types.Form, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	form, ok := m.pendingForms[id]
+	return form, ok
+}
+
+// GetLastForm returns the pending form with the highest ID
+func (m *MockAgent) GetLastForm() types.Form {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var lastForm types.Form
+	var maxID int32 = -1
+	for id, form := range m.pendingForms {
+		if id > maxID {
+			maxID = id
+			lastForm = form
+		}
+	}
+	return lastForm
+}
+
+// SubmitForm records the response and removes the form from the pending forms
+func (m *MockAgent) SubmitForm(formID int32, response types.FormResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.pendingForms[formID]; !ok {
+		return fmt.Errorf("form with ID %d not found", formID)
+	}
+	delete(m.pendingForms, formID)
+	m.submitted = append(m.submitted, SubmittedForm{FormID: formID, Response: response})
+	return nil
+}
+
+// ClearPendingForms removes all pending forms
+func (m *MockAgent) ClearPendingForms() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pendingForms = make(map[int32]types.Form)
+}
+
+// Emitter returns the mock agent's event emitter
+func (m *MockAgent) Emitter() *events.Emitter {
+	return m.emitter
+}
@@ -0,0 +1,252 @@
+package besttest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	"github.com/gollilla/best/pkg/assertions"
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// expectFailure runs fn and reports whether it panicked with an assertion error
+func expectFailure(t *testing.T, fn func()) (failed bool) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*assertions.AssertionError); !ok {
+				t.Fatalf("unexpected panic: %v", r)
+			}
+			failed = true
+		}
+	}()
+	fn()
+	return false
+}
+
+func TestMockAgentHaveSeen(t *testing.T) {
+	tests := []struct {
+		name   string
+		inject func(m *MockAgent)
+		assert func(m *MockAgent)
+		fail   bool
+	}{
+		{
+			name: "chat already received",
+			inject: func(m *MockAgent) {
+				m.Inject(events.EventChat, &types.ChatMessage{Message: "done"})
+			},
+			assert: func(m *MockAgent) { m.Expect().Chat().ToHaveSeen("done", 0, nil) },
+		},
+		{
+			name: "chat arrives later",
+			inject: func(m *MockAgent) {
+				m.InjectAfter(50*time.Millisecond, events.EventChat, &types.ChatMessage{Message: "done"})
+			},
+			assert: func(m *MockAgent) { m.Expect().Chat().ToHaveSeen("done", time.Second, nil) },
+		},
+		{
+			name: "chat from another sender",
+			inject: func(m *MockAgent) {
+				m.Inject(events.EventChat, &types.ChatMessage{Message: "done", Sender: "Alex"})
+			},
+			assert: func(m *MockAgent) {
+				m.Expect().Chat().ToHaveSeen("done", 0, &assertions.ChatOptions{From: "Steve"})
+			},
+			fail: true,
+		},
+		{
+			name:   "chat never received",
+			inject: func(m *MockAgent) {},
+			assert: func(m *MockAgent) { m.Expect().Chat().ToHaveSeen("done", 50*time.Millisecond, nil) },
+			fail:   true,
+		},
+		{
+			name: "title arrives later",
+			inject: func(m *MockAgent) {
+				m.InjectAfter(50*time.Millisecond, events.EventTitle, &types.TitleDisplay{Type: "title", Text: "Welcome"})
+			},
+			assert: func(m *MockAgent) { m.Expect().Title().ToHaveSeen("Welcome", time.Second) },
+		},
+		{
+			name: "subtitle is not a title",
+			inject: func(m *MockAgent) {
+				m.Inject(events.EventTitle, &types.TitleDisplay{Type: "subtitle", Text: "Welcome"})
+			},
+			assert: func(m *MockAgent) { m.Expect().Title().ToHaveSeen("Welcome", 50*time.Millisecond) },
+			fail:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockAgent()
+			tt.inject(m)
+			if failed := expectFailure(t, func() { tt.assert(m) }); failed != tt.fail {
+				t.Errorf("assertion failed = %v, want %v", failed, tt.fail)
+			}
+		})
+	}
+}
+
+func TestMockAgentHealthToBeFull(t *testing.T) {
+	tests := []struct {
+		name      string
+		health    float32
+		maxHealth float32
+		fail      bool
+	}{
+		{"default maximum", 20, 20, false},
+		{"below maximum", 19, 20, true},
+		{"raised maximum", 40, 40, false},
+		{"20 below raised maximum", 20, 40, true},
+		{"lowered maximum", 10, 10, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockAgent()
+			m.SetMaxHealth(tt.maxHealth)
+			m.SetHealth(tt.health)
+			if failed := expectFailure(t, func() { m.Expect().Health().ToBeFull() }); failed != tt.fail {
+				t.Errorf("assertion failed = %v, want %v", failed, tt.fail)
+			}
+		})
+	}
+}
+
+func TestMockAgentFormHaveReceived(t *testing.T) {
+	settings := &types.CustomForm{
+		ID:    1,
+		Title: "Server Settings",
+		Content: []types.FormElement{
+			&types.Toggle{Text: "Enable PvP", Default: true},
+			&types.Slider{Text: "Volume", Min: 0, Max: 10, Step: 1, Default: 5},
+			&types.Input{Text: "Name", Default: "Steve"},
+			&types.Dropdown{Text: "Color", Options: []string{"red", "blue"}, Default: 1},
+		},
+	}
+	confirm := &types.ModalForm{ID: 2, Title: "Confirm", Button1: "Yes, delete", Button2: "Cancel"}
+
+	tests := []struct {
+		name   string
+		forms  []types.Form
+		assert func(f *assertions.FormAssertion)
+		fail   bool
+	}{
+		{"no form", nil, func(f *assertions.FormAssertion) {}, true},
+		{"title contained", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToContainTitle("Settings") }, false},
+		{"toggle", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveToggleDefault("Enable PvP", true) }, false},
+		{"partial toggle label", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveToggleDefault("PvP", true) }, true},
+		{"partial input label", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveInput("Nam") }, true},
+		{"slider", []types.Form{settings}, func(f *assertions.FormAssertion) {
+			f.ToHaveSliderRange("Volume", 0, 10).ToHaveSliderStep("Volume", 1).ToHaveSliderDefault("Volume", 5)
+		}, false},
+		{"slider default differs", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveSliderDefault("Volume", 3) }, true},
+		{"input", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveInputDefault("Name", "Steve") }, false},
+		{"dropdown", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveDropdownDefault("Color", "blue") }, false},
+		{"wrong element type", []types.Form{settings}, func(f *assertions.FormAssertion) { f.ToHaveInputDefault("Volume", "5") }, true},
+		{"latest form is used", []types.Form{settings, confirm}, func(f *assertions.FormAssertion) { f.ToBeModal() }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockAgent()
+			for _, form := range tt.forms {
+				m.AddForm(form)
+			}
+			failed := expectFailure(t, func() { tt.assert(m.Expect().Form().ToHaveReceived()) })
+			if failed != tt.fail {
+				t.Errorf("assertion failed = %v, want %v", failed, tt.fail)
+			}
+		})
+	}
+}
+
+func TestMockAgentInjectOnWait(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  events.EventName
+		data   events.EventData
+		assert func(m *MockAgent)
+	}{
+		{
+			name:   "chat",
+			event:  events.EventChat,
+			data:   &types.ChatMessage{Message: "hello"},
+			assert: func(m *MockAgent) { m.Expect().Chat().ToReceive("hello", time.Second, nil) },
+		},
+		{
+			name:   "title",
+			event:  events.EventTitle,
+			data:   &types.TitleDisplay{Type: "title", Text: "Welcome"},
+			assert: func(m *MockAgent) { m.Expect().Title().ToReceive("Welcome", time.Second) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockAgent()
+			m.InjectOnWait(tt.event, tt.data)
+			if expectFailure(t, func() { tt.assert(m) }) {
+				t.Error("injected event was not delivered to the waiting assertion")
+			}
+		})
+	}
+}
+
+func TestMockAgentStateCopiesScoreboard(t *testing.T) {
+	m := NewMockAgent()
+	m.SetOwnScore("money", 100)
+
+	state := m.State()
+	for _, entry := range state.Scoreboard.Entries {
+		entry.Score = 0
+	}
+	delete(state.Scoreboard.Objectives, "money")
+
+	if score := m.GetScore("money"); score == nil || *score != 100 {
+		t.Errorf("GetScore(money) = %v after modifying a State copy, want 100", score)
+	}
+	if _, ok := m.State().Scoreboard.Objectives["money"]; !ok {
+		t.Error("objective removed from a State copy is missing from the mock")
+	}
+}
+
+func TestMockAgentGamemodeToBeWithin(t *testing.T) {
+	m := NewMockAgent()
+	go func() {
+		for m.emitter.ListenerCount(events.EventGamemodeUpdate) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		m.SetGamemode(assertions.GamemodeCreative)
+		m.emitter.Emit(events.EventGamemodeUpdate, assertions.GamemodeCreative)
+	}()
+
+	if expectFailure(t, func() { m.Expect().Gamemode().ToBeWithin(assertions.GamemodeCreative, time.Second) }) {
+		t.Error("ToBeWithin missed the gamemode update")
+	}
+	if !expectFailure(t, func() { m.Expect().Gamemode().ToBeWithin(assertions.GamemodeSpectator, 50*time.Millisecond) }) {
+		t.Error("ToBeWithin passed for a gamemode that never came")
+	}
+}
+
+func TestMockAgentToReceivePacket(t *testing.T) {
+	m := NewMockAgent()
+	go func() {
+		for m.emitter.ListenerCount(events.EventPacket) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		m.SetPacketCount(packet.IDSetScore, 2)
+		m.emitter.Emit(events.EventPacket, map[string]interface{}{"packet": &packet.SetScore{}})
+	}()
+
+	if expectFailure(t, func() { m.Expect().Packet().ToReceivePacket(packet.IDSetScore, 2, time.Second) }) {
+		t.Error("ToReceivePacket missed the packet")
+	}
+	if !expectFailure(t, func() { m.Expect().Packet().ToReceivePacket(packet.IDSetScore, 3, 50*time.Millisecond) }) {
+		t.Error("ToReceivePacket passed without enough packets")
+	}
+}
@@ -0,0 +1,75 @@
+package besttest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/gollilla/best/pkg/agent"
+	bestprotocol "github.com/gollilla/best/pkg/protocol"
+)
+
+// ReplayAgent is an agent driven by a session recorded with agent.WithSessionRecorder
+// Assertions run against it exactly as against a live agent, without server access.
+type ReplayAgent struct {
+	*agent.Agent
+	session *bestprotocol.SessionReader
+}
+
+// NewReplayAgent opens a recorded session and creates a spawned agent ready to replay it
+func NewReplayAgent(path string, opts ...agent.AgentOption) (*ReplayAgent, error) {
+	session, err := bestprotocol.OpenSession(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info := session.Info()
+	opts = append([]agent.AgentOption{agent.WithUsername(info.Username)}, opts...)
+	a := agent.NewAgent(opts...)
+	a.StartReplay(info)
+
+	return &ReplayAgent{
+		Agent:   a,
+		session: session,
+	}, nil
+}
+
+// Play feeds the recorded packets to the agent until the session ends or ctx is done
+// With realtime set, the original timing between packets is preserved; otherwise
+// packets are replayed as fast as possible.
+func (r *ReplayAgent) Play(ctx context.Context, realtime bool) error {
+	start := time.Now()
+	for {
+		elapsed, pk, err := r.session.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if realtime {
+			if wait := elapsed - time.Since(start); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		r.ReplayPacket(pk)
+	}
+}
+
+// Close closes the session file
+func (r *ReplayAgent) Close() error {
+	return r.session.Close()
+}
@@ -10,16 +10,23 @@ import (
 
 // Config represents the configuration for Best testing framework
 type Config struct {
-	Server  ServerConfig  `yaml:"server"`
-	Agent   AgentConfig   `yaml:"agent"`
-	AI      AIConfig      `yaml:"ai,omitempty"`
-	Webhook WebhookConfig `yaml:"webhook,omitempty"`
+	Server  ServerConfig        `yaml:"server"`
+	Agent   AgentConfig         `yaml:"agent"`
+	AI      AIConfig            `yaml:"ai,omitempty"`
+	Webhook WebhookConfig       `yaml:"webhook,omitempty"`
+	Macros  map[string][]string `yaml:"macros,omitempty"`  // Named command sequences for Agent.RunMacro
+	Aliases map[string][]string `yaml:"aliases,omitempty"` // Item/block aliases; best.UseConfigAliases adds them and enables alias matching
 }
 
 // WebhookConfig contains webhook notification settings
 type WebhookConfig struct {
-	URL    string   `yaml:"url"`              // Webhook URL (supports ${ENV_VAR} syntax)
-	Events []string `yaml:"events,omitempty"` // Events to notify: "scenario_complete", "scenario_failed", "step_failed"
+	URL              string   `yaml:"url"`                        // Webhook URL (supports ${ENV_VAR} syntax)
+	Events           []string `yaml:"events,omitempty"`           // Events to notify: "scenario_complete", "scenario_failed", "step_failed"
+	MentionOnFailure []string `yaml:"mentionOnFailure,omitempty"` // Discord role IDs (or "<@userid>" mentions) to ping on failures
+	Timeout          int      `yaml:"timeout,omitempty"`          // Default send timeout in seconds (default: 10)
+	ResultTimeout    int      `yaml:"resultTimeout,omitempty"`    // Send timeout for scenario results in seconds (default: timeout)
+	StepTimeout      int      `yaml:"stepTimeout,omitempty"`      // Send timeout for step failures in seconds (default: timeout)
+	SummaryTimeout   int      `yaml:"summaryTimeout,omitempty"`   // Send timeout for summaries in seconds (default: timeout)
 }
 
 // ServerConfig contains server connection settings
@@ -33,15 +40,22 @@ type ServerConfig struct {
 type AgentConfig struct {
 	Username          string `yaml:"username"`
 	Timeout           int    `yaml:"timeout,omitempty"`           // in seconds
+	SpawnTimeout      int    `yaml:"spawnTimeout,omitempty"`      // spawn handshake timeout in seconds
+	WaitForAttributes int    `yaml:"waitForAttributes,omitempty"` // first attribute sync timeout in seconds (0 = don't wait)
 	CommandPrefix     string `yaml:"commandPrefix,omitempty"`
 	CommandSendMethod string `yaml:"commandSendMethod,omitempty"` // "text" or "request"
 	CommandTimeout    int    `yaml:"commandTimeout,omitempty"`    // assertion wait timeout in seconds
+	MaxOutputLines    int    `yaml:"maxOutputLines,omitempty"`    // command output line cap (0 = unlimited)
+	EventBufferSize   int    `yaml:"eventBufferSize,omitempty"`   // events buffered per listener (default 100)
+	MovementMode      string `yaml:"movementMode,omitempty"`      // "move_player" or "auth_input"
+	ResourcePacks     string `yaml:"resourcePacks,omitempty"`     // "accept" (default) or "decline"
 }
 
 // AIConfig contains AI/LLM settings for scenario execution
 type AIConfig struct {
-	Provider    string         `yaml:"provider"`              // "openai" or "anthropic"
+	Provider    string         `yaml:"provider"`              // "openai", "anthropic", "gemini" or "ollama"
 	APIKey      string         `yaml:"apiKey"`                // API key (supports ${ENV_VAR} syntax)
+	BaseURL     string         `yaml:"baseURL,omitempty"`     // Server address for "ollama" (default: http://localhost:11434)
 	Model       string         `yaml:"model"`                 // Model name (e.g., "gpt-4", "claude-3-sonnet")
 	Temperature float64        `yaml:"temperature,omitempty"` // Creativity (0.0-1.0)
 	MaxTokens   int            `yaml:"maxTokens,omitempty"`   // Maximum tokens
@@ -115,10 +129,23 @@ func LoadConfigFromFile(path string) (*Config, error) {
 	if err := yaml.Unmarshal(data, config); err != nil {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file: %w", err)
+	}
 
 	return config, nil
 }
 
+// validate rejects values that would otherwise be silently misread
+func (c *Config) validate() error {
+	switch c.Agent.ResourcePacks {
+	case "", "accept", "decline":
+	default:
+		return fmt.Errorf("agent.resourcePacks must be \"accept\" or \"decline\" (got %q)", c.Agent.ResourcePacks)
+	}
+	return nil
+}
+
 // findConfigFile searches for best.config.yml or best.config.yaml
 // It starts from the current directory and walks up to parent directories
 func findConfigFile() (string, error) {
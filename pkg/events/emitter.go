@@ -8,10 +8,14 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultBufferSize is the number of events buffered per asynchronous listener
+const DefaultBufferSize = 100
+
 // Emitter is a channel-based event emitter that replaces TypeScript's EventEmitter
 type Emitter struct {
-	listeners map[EventName]map[string]*listener
-	mu        sync.RWMutex
+	listeners  map[EventName]map[string]*listener
+	bufferSize int
+	mu         sync.RWMutex
 }
 
 // listener represents a single event listener
@@ -27,8 +31,19 @@ type listener struct {
 
 // NewEmitter creates a new event emitter
 func NewEmitter() *Emitter {
+	return NewEmitterWithBuffer(DefaultBufferSize)
+}
+
+// NewEmitterWithBuffer creates an event emitter that buffers size events per asynchronous listener
+// Events emitted while a listener's buffer is full are dropped for that listener, so raise this
+// for busy servers (chunk streaming, many entities). Sizes below 1 use DefaultBufferSize.
+func NewEmitterWithBuffer(size int) *Emitter {
+	if size < 1 {
+		size = DefaultBufferSize
+	}
 	return &Emitter{
-		listeners: make(map[EventName]map[string]*listener),
+		listeners:  make(map[EventName]map[string]*listener),
+		bufferSize: size,
 	}
 }
 
@@ -74,7 +89,7 @@ func (e *Emitter) on(event EventName, handler func(EventData), once bool) string
 	id := uuid.New().String()
 	l := &listener{
 		id:      id,
-		ch:      make(chan EventData, 100), // Larger buffer to handle bursts
+		ch:      make(chan EventData, e.bufferSize),
 		once:    once,
 		handler: handler,
 	}
@@ -119,8 +134,12 @@ func (e *Emitter) Off(event EventName, id string) {
 // Synchronous listeners (registered via OnSync) are called directly and complete
 // before any asynchronous listeners receive the event.
 func (e *Emitter) Emit(event EventName, data EventData) {
+	// Copy the listeners so On and Off may run while the event is dispatched
 	e.mu.RLock()
-	listeners := e.listeners[event]
+	listeners := make([]*listener, 0, len(e.listeners[event]))
+	for _, l := range e.listeners[event] {
+		listeners = append(listeners, l)
+	}
 	e.mu.RUnlock()
 
 	// Run sync listeners first, inline, so callers see their effects immediately.
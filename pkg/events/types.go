@@ -13,32 +13,46 @@ const (
 	EventPositionUpdate  EventName = "position_update"
 	EventHealthUpdate    EventName = "health_update"
 	EventHungerUpdate    EventName = "hunger_update"
+	EventExperienceUpdate EventName = "experience_update"
+	EventTimeUpdate      EventName = "time_update"
+	EventWeatherUpdate   EventName = "weather_update"
 	EventGamemodeUpdate  EventName = "gamemode_update"
 	EventForm            EventName = "form"
 	EventCommandOutput   EventName = "command_output"
 	EventChunkLoaded     EventName = "chunk_loaded"
+	EventSubChunkLoaded  EventName = "sub_chunk_loaded"
+	EventBlockPalette    EventName = "block_palette"
 	EventBlockUpdate     EventName = "block_update"
 	EventBlockBreakStart EventName = "block_break_start"
 	EventBlockBreakAbort EventName = "block_break_abort"
 	EventBlockBreakComplete EventName = "block_break_complete"
 	EventInventoryUpdate    EventName = "inventory_update"
 	EventInventorySlotUpdate EventName = "inventory_slot_update"
+	EventInventoryChanged    EventName = "inventory_changed"
+	EventHeldSlotUpdate      EventName = "held_slot_update"
 	EventEffectAdd          EventName = "effect_add"
 	EventEffectRemove       EventName = "effect_remove"
 	EventEffectUpdate       EventName = "effect_update"
 	EventEntityAdd          EventName = "entity_add"
 	EventEntitySpawn        EventName = "entity_spawn"
 	EventEntityRemove       EventName = "entity_remove"
+	EventEntityMove         EventName = "entity_move"
 	EventScoreUpdate        EventName = "score_update"
 	EventPermissionUpdate   EventName = "permission_update"
 	EventTagUpdate          EventName = "tag_update"
 	EventTitle              EventName = "title"
 	EventSound           EventName = "sound"
 	EventParticle        EventName = "particle"
+	EventBossBar         EventName = "boss_bar"
 	EventDimensionChange EventName = "dimension_change"
 	EventDeath           EventName = "death"
 	EventRespawn         EventName = "respawn"
 	EventTeleport        EventName = "teleport"
+	EventMoveCorrected   EventName = "move_corrected"
+	EventPlayerJoin      EventName = "player_join"
+	EventPlayerLeave     EventName = "player_leave"
+	EventPlayerListUpdate EventName = "player_list_update"
+	EventServerTick      EventName = "server_tick"
 	EventPacket          EventName = "packet"
 )
 
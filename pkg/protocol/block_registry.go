@@ -0,0 +1,49 @@
+package protocol
+
+import (
+	"fmt"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/world"
+)
+
+// BlockPalette maps block runtime IDs to block names for the connected server
+// Names are only known when the server uses hashed block network IDs (UseBlockNetworkIDHashes):
+// the hash of every known block name in its default state (no block states) is registered, which
+// covers blocks such as minecraft:stone or minecraft:oak_planks. Blocks whose default state has
+// states (e.g. minecraft:chest) and servers using palette-index IDs leave runtime IDs unnamed.
+func BlockPalette(hashedIDs bool, customBlocks []protocol.BlockEntry, items []protocol.ItemEntry) map[uint32]string {
+	palette := make(map[uint32]string)
+	if !hashedIDs {
+		return palette
+	}
+
+	register := func(name string) {
+		if id, err := world.NetworkBlockHash(name, nil); err == nil {
+			palette[id] = name
+		}
+	}
+
+	register("minecraft:air")
+	InitItemRegistry()
+	for name := range NameToNetworkID {
+		register(name)
+	}
+	for _, item := range items {
+		register(item.Name)
+	}
+	for _, block := range customBlocks {
+		register(block.Name)
+	}
+	return palette
+}
+
+// emitBlockPalette emits the block palette for the connection, warning when blocks cannot be named
+func (c *Client) emitBlockPalette(hashedIDs bool, customBlocks []protocol.BlockEntry, items []protocol.ItemEntry) {
+	if !hashedIDs {
+		fmt.Printf("[WARN] Server does not use hashed block network IDs; block names are unavailable to block assertions\n")
+	}
+	c.emitter.Emit(events.EventBlockPalette, BlockPalette(hashedIDs, customBlocks, items))
+}
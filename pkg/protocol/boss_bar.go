@@ -0,0 +1,101 @@
+package protocol
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// bossBarColours maps packet.BossEventColour* values to names
+var bossBarColours = map[uint32]string{
+	packet.BossEventColourGrey:   "grey",
+	packet.BossEventColourBlue:   "blue",
+	packet.BossEventColourRed:    "red",
+	packet.BossEventColourGreen:  "green",
+	packet.BossEventColourYellow: "yellow",
+	packet.BossEventColourPurple: "purple",
+	packet.BossEventColourWhite:  "white",
+}
+
+// bossBarColour returns the name of a boss bar colour
+func bossBarColour(colour uint32) string {
+	if name, ok := bossBarColours[colour]; ok {
+		return name
+	}
+	return fmt.Sprintf("colour:%d", colour)
+}
+
+// handleBossEvent tracks the boss bars shown to the agent and emits EventBossBar
+// Update events only carry the changed fields, so they are merged into the tracked bar.
+func (c *Client) handleBossEvent(pk packet.Packet) {
+	p := pk.(*packet.BossEvent)
+
+	c.bossBarsMu.Lock()
+	bar, ok := c.bossBars[p.BossEntityUniqueID]
+
+	switch p.EventType {
+	case packet.BossEventShow:
+		bar = &types.BossBar{
+			EntityUniqueID: p.BossEntityUniqueID,
+			Title:          p.BossBarTitle,
+			Percentage:     p.HealthPercentage,
+			Color:          bossBarColour(p.Colour),
+		}
+		c.bossBars[p.BossEntityUniqueID] = bar
+	case packet.BossEventHide:
+		if !ok {
+			c.bossBarsMu.Unlock()
+			return
+		}
+		delete(c.bossBars, p.BossEntityUniqueID)
+		bar.Removed = true
+	case packet.BossEventHealthPercentage, packet.BossEventTitle, packet.BossEventAppearanceProperties, packet.BossEventTexture:
+		if !ok {
+			c.bossBarsMu.Unlock()
+			return
+		}
+		switch p.EventType {
+		case packet.BossEventHealthPercentage:
+			bar.Percentage = p.HealthPercentage
+		case packet.BossEventTitle:
+			bar.Title = p.BossBarTitle
+		default:
+			bar.Color = bossBarColour(p.Colour)
+		}
+	default:
+		c.bossBarsMu.Unlock()
+		return
+	}
+
+	snapshot := *bar
+	c.bossBarsMu.Unlock()
+
+	c.emitter.Emit(events.EventBossBar, &snapshot)
+}
+
+// resetBossBars clears the tracked boss bars
+func (c *Client) resetBossBars() {
+	c.bossBarsMu.Lock()
+	c.bossBars = make(map[int64]*types.BossBar)
+	c.bossBarsMu.Unlock()
+}
+
+// BossBars returns the boss bars currently shown to the agent, sorted by boss entity ID
+func (c *Client) BossBars() []types.BossBar {
+	c.bossBarsMu.Lock()
+	defer c.bossBarsMu.Unlock()
+
+	bars := make([]types.BossBar, 0, len(c.bossBars))
+	for _, bar := range c.bossBars {
+		bars = append(bars, *bar)
+	}
+	slices.SortFunc(bars, func(x, y types.BossBar) int {
+		return cmp.Compare(x.EntityUniqueID, y.EntityUniqueID)
+	})
+	return bars
+}
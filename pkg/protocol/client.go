@@ -3,9 +3,13 @@ package protocol
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sandertv/gophertunnel/minecraft"
@@ -16,6 +20,9 @@ import (
 	"github.com/gollilla/best/pkg/types"
 )
 
+// ErrNotConnected is returned when sending to a client without an open connection
+var ErrNotConnected = errors.New("not connected")
+
 // Client wraps gophertunnel's minecraft.Conn and manages packet handling
 type Client struct {
 	conn       *minecraft.Conn
@@ -25,6 +32,38 @@ type Client struct {
 	wg         sync.WaitGroup
 	state      *types.PlayerState
 	identifier string // Agent name or identifier for debugging
+	recorder   *SessionRecorder
+
+	// Session file recorded by this client and when recording started, so reconnects append to it
+	recordPath  string
+	recordStart time.Time
+
+	// Player list, keyed by UUID
+	players   map[string]*types.PlayerListEntry
+	playersMu sync.Mutex
+
+	// Number of packets received per packet ID since connecting
+	packetCounts   map[uint32]int
+	packetCountsMu sync.Mutex
+
+	// Boss bars currently shown, keyed by boss entity unique ID
+	bossBars   map[int64]*types.BossBar
+	bossBarsMu sync.Mutex
+
+	// Command lines of CommandRequests awaiting output, keyed by origin UUID
+	commands   map[uuid.UUID]trackedCommand
+	commandsMu sync.Mutex
+
+	// Set from death until the server respawns the player, so each death is reported once
+	dead atomic.Bool
+
+	// Most recent death, completed by a DeathInfo that arrives after health 0
+	lastDeath   *types.Death
+	lastDeathMu sync.Mutex
+
+	// Weather flags from StartGame and LevelEvent, combined into state.Weather
+	raining    bool
+	thundering bool
 
 	// Packet handlers
 	handlers map[uint32]PacketHandler
@@ -40,12 +79,16 @@ func NewClient(emitter *events.Emitter, state *types.PlayerState, identifier str
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Client{
-		emitter:    emitter,
-		ctx:        ctx,
-		cancel:     cancel,
-		state:      state,
-		identifier: identifier,
-		handlers:   make(map[uint32]PacketHandler),
+		emitter:      emitter,
+		ctx:          ctx,
+		cancel:       cancel,
+		state:        state,
+		identifier:   identifier,
+		handlers:     make(map[uint32]PacketHandler),
+		players:      make(map[string]*types.PlayerListEntry),
+		bossBars:     make(map[int64]*types.BossBar),
+		packetCounts: make(map[uint32]int),
+		commands:     make(map[uuid.UUID]trackedCommand),
 	}
 }
 
@@ -59,6 +102,13 @@ func (c *Client) Connect(opts types.ClientOptions) error {
 		KeepXBLIdentityData: true, // Keep XUID for unique player UUIDs on PNX
 	}
 
+	// Accept or decline the resource packs offered during login, remembering how many were offered
+	packsOffered := 0
+	dialer.DownloadResourcePack = func(id uuid.UUID, version string, current, total int) bool {
+		packsOffered = total
+		return !opts.DeclineResourcePacks
+	}
+
 	// Set username in IdentityData with UUID and XUID
 	if opts.Username != "" {
 		// Generate unique XUID for each player to avoid UUID collision in PNX
@@ -66,19 +116,32 @@ func (c *Client) Connect(opts types.ClientOptions) error {
 		// XUID should be 16 digits to match Xbox Live format and database constraints
 		xuid := opts.XUID
 		if xuid == "" {
-			xuid = generateXUID()
+			xuid = GenerateXUID()
+		}
+		identity := opts.Identity
+		if identity == "" {
+			identity = uuid.New().String()
 		}
 		dialer.IdentityData = login.IdentityData{
 			DisplayName: opts.Username,
-			Identity:    uuid.New().String(),
+			Identity:    identity,
 			XUID:        xuid,
 		}
+		// Received chat is attributed to the name actually logged in with
+		c.identifier = opts.Username
 	}
 
 	// Dial the server
 	addr := fmt.Sprintf("%s:%d", opts.Host, opts.Port)
 	conn, err := dialer.Dial("raknet", addr)
 	if err != nil {
+		if packsOffered > 0 {
+			action := "downloading"
+			if opts.DeclineResourcePacks {
+				action = "declining"
+			}
+			return fmt.Errorf("failed to connect after %s %d resource pack(s) (see WithResourcePacks): %w", action, packsOffered, err)
+		}
 		return fmt.Errorf("failed to connect: %w", err)
 	}
 
@@ -92,6 +155,7 @@ func (c *Client) Connect(opts types.ClientOptions) error {
 		Z: float64(gameData.PlayerPosition.Z()),
 	}
 	c.state.Gamemode = gameData.PlayerGameMode
+	c.state.Time = int32(gameData.Time)
 	c.state.PermissionLevel = gameData.PlayerPermissions
 
 	// Initialize scoreboard state
@@ -100,12 +164,48 @@ func (c *Client) Connect(opts types.ClientOptions) error {
 		Entries:    make(map[int64]*types.ScoreboardEntry),
 	}
 
+	// Start recording the session if requested, continuing the same file after a reconnect
+	if opts.SessionRecordPath != "" {
+		var recorder *SessionRecorder
+		if opts.SessionRecordPath == c.recordPath {
+			recorder, err = AppendSessionRecorder(opts.SessionRecordPath, c.recordStart, shieldID(gameData))
+		} else {
+			recorder, err = NewSessionRecorder(opts.SessionRecordPath, SessionInfo{
+				Username:        opts.Username,
+				RuntimeEntityID: int64(gameData.EntityRuntimeID),
+				Position:        c.state.Position,
+				Gamemode:        c.state.Gamemode,
+				PermissionLevel: c.state.PermissionLevel,
+				ShieldID:        shieldID(gameData),
+				HashedBlockIDs:  gameData.UseBlockNetworkIDHashes,
+			})
+		}
+		if err != nil {
+			conn.Close()
+			return err
+		}
+		c.recorder = recorder
+		c.recordPath, c.recordStart = opts.SessionRecordPath, recorder.Start()
+	}
+
+	c.resetPlayerList()
+	c.resetBossBars()
+	c.resetPacketCounts()
+	c.resetCommands()
+	c.resetDeath()
+	c.raining, c.thundering = false, false
+	c.emitBlockPalette(gameData.UseBlockNetworkIDHashes, gameData.CustomBlocks, gameData.Items)
+
 	// Register packet handlers
 	c.registerHandlers()
 
+	// Each connection gets its own context: the previous one was cancelled by Disconnect
+	ctx, cancel := context.WithCancel(context.Background())
+	c.ctx, c.cancel = ctx, cancel
+
 	// Start packet reading goroutine
 	c.wg.Add(1)
-	go c.readPackets()
+	go c.readPackets(ctx)
 
 	// Emit join event
 	c.emitter.Emit(events.EventJoin, nil)
@@ -113,14 +213,22 @@ func (c *Client) Connect(opts types.ClientOptions) error {
 	return nil
 }
 
-// DoSpawn performs the spawn sequence
-func (c *Client) DoSpawn() error {
+// DoSpawn performs the spawn sequence, giving up after timeout
+func (c *Client) DoSpawn(timeout time.Duration) error {
 	if c.conn == nil {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 
-	// Perform spawn sequence
-	if err := c.conn.DoSpawn(); err != nil {
+	// Perform spawn sequence (0 uses gophertunnel's default of one minute)
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := c.conn.DoSpawnContext(ctx); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("spawn timed out after %v: server did not complete the spawn sequence", timeout)
+		}
 		return fmt.Errorf("failed to spawn: %w", err)
 	}
 
@@ -151,47 +259,94 @@ func (c *Client) Disconnect() error {
 	}
 
 	c.wg.Wait()
+
+	if c.recorder != nil {
+		if err := c.recorder.Close(); err != nil {
+			return err
+		}
+		c.recorder = nil
+	}
 	return nil
 }
 
+// StartReplay prepares the client to be fed recorded packets through HandlePacket
+// instead of reading them from a connection
+func (c *Client) StartReplay(info SessionInfo) {
+	c.state.RuntimeEntityID = info.RuntimeEntityID
+	c.state.Position = info.Position
+	c.state.Gamemode = info.Gamemode
+	c.state.PermissionLevel = info.PermissionLevel
+	c.state.Scoreboard = &types.ScoreboardState{
+		Objectives: make(map[string]*types.ScoreboardObjective),
+		Entries:    make(map[int64]*types.ScoreboardEntry),
+	}
+	c.resetPlayerList()
+	c.resetBossBars()
+	c.resetPacketCounts()
+	c.resetCommands()
+	c.resetDeath()
+	c.raining, c.thundering = false, false
+	c.emitBlockPalette(info.HashedBlockIDs, nil, nil)
+
+	c.registerHandlers()
+}
+
 // WritePacket sends a packet to the server
 func (c *Client) WritePacket(pk packet.Packet) error {
 	if c.conn == nil {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 	return c.conn.WritePacket(pk)
 }
 
-// readPackets continuously reads packets from the connection
-func (c *Client) readPackets() {
+// readPackets continuously reads packets from the connection until ctx is cancelled
+func (c *Client) readPackets(ctx context.Context) {
 	defer c.wg.Done()
 
 	for {
 		select {
-		case <-c.ctx.Done():
+		case <-ctx.Done():
 			return
 		default:
 			pk, err := c.conn.ReadPacket()
 			if err != nil {
+				if ctx.Err() != nil {
+					// Closed by Disconnect
+					return
+				}
 				c.emitter.Emit(events.EventError, err)
 				c.emitter.Emit(events.EventDisconnect, "Connection error")
 				return
 			}
 
-			// Handle the packet
-			c.handlePacket(pk)
+			if c.recorder != nil {
+				_ = c.recorder.Record(pk)
+			}
 
-			// Emit generic packet event for debugging
-			c.emitter.Emit(events.EventPacket, map[string]interface{}{
-				"name":   fmt.Sprintf("%T", pk),
-				"packet": pk,
-			})
+			c.HandlePacket(pk)
 		}
 	}
 }
 
-// handlePacket routes packets to registered handlers
+// HandlePacket routes a packet to its handler and emits the generic packet event
+func (c *Client) HandlePacket(pk packet.Packet) {
+	// Handle the packet
+	c.observeTick(pk)
+	c.handlePacket(pk)
+
+	// Emit generic packet event for debugging
+	c.emitter.Emit(events.EventPacket, map[string]interface{}{
+		"name":   fmt.Sprintf("%T", pk),
+		"packet": pk,
+	})
+}
+
+// handlePacket counts packets and routes them to registered handlers
 func (c *Client) handlePacket(pk packet.Packet) {
+	c.packetCountsMu.Lock()
+	c.packetCounts[pk.ID()]++
+	c.packetCountsMu.Unlock()
+
 	c.mu.RLock()
 	handler, ok := c.handlers[pk.ID()]
 	c.mu.RUnlock()
@@ -201,6 +356,21 @@ func (c *Client) handlePacket(pk packet.Packet) {
 	}
 }
 
+// PacketCount returns how many packets with the given ID were received since connecting
+// Packet IDs are the packet.ID* constants, e.g. packet.IDSetScore.
+func (c *Client) PacketCount(packetID uint32) int {
+	c.packetCountsMu.Lock()
+	defer c.packetCountsMu.Unlock()
+	return c.packetCounts[packetID]
+}
+
+// resetPacketCounts clears the packet counters
+func (c *Client) resetPacketCounts() {
+	c.packetCountsMu.Lock()
+	c.packetCounts = make(map[uint32]int)
+	c.packetCountsMu.Unlock()
+}
+
 // RegisterHandler registers a custom packet handler
 func (c *Client) RegisterHandler(packetID uint32, handler PacketHandler) {
 	c.mu.Lock()
@@ -213,21 +383,33 @@ func (c *Client) registerHandlers() {
 	// Phase 1: Core handlers
 	c.RegisterHandler(packet.IDText, c.handleText)
 	c.RegisterHandler(packet.IDMovePlayer, c.handleMovePlayer)
+	c.RegisterHandler(packet.IDCorrectPlayerMovePrediction, c.handleCorrectPlayerMovePrediction)
 	c.RegisterHandler(packet.IDStartGame, c.handleStartGame)
 	c.RegisterHandler(packet.IDUpdateAttributes, c.handleUpdateAttributes)
+	c.RegisterHandler(packet.IDSetHealth, c.handleSetHealth)
+	c.RegisterHandler(packet.IDDeathInfo, c.handleDeathInfo)
+	c.RegisterHandler(packet.IDRespawn, c.handleRespawn)
 	c.RegisterHandler(packet.IDSetPlayerGameType, c.handleSetPlayerGameType)
+	c.RegisterHandler(packet.IDSetTime, c.handleSetTime)
+	c.RegisterHandler(packet.IDLevelEvent, c.handleLevelEvent)
 	c.RegisterHandler(packet.IDUpdateAbilities, c.handleUpdateAbilities)
 	c.RegisterHandler(packet.IDDisconnect, c.handleDisconnect)
 	c.RegisterHandler(packet.IDCommandOutput, c.handleCommandOutput)
 
 	// Phase 2: World and state handlers
 	c.RegisterHandler(packet.IDUpdateBlock, c.handleUpdateBlock)
+	c.RegisterHandler(packet.IDUpdateSubChunkBlocks, c.handleUpdateSubChunkBlocks)
 	c.RegisterHandler(packet.IDInventoryContent, c.handleInventoryContent)
 	c.RegisterHandler(packet.IDInventorySlot, c.handleInventorySlot)
+	c.RegisterHandler(packet.IDMobEquipment, c.handleMobEquipment)
+	c.RegisterHandler(packet.IDPlayerHotBar, c.handlePlayerHotBar)
 	c.RegisterHandler(packet.IDMobEffect, c.handleMobEffect)
 	c.RegisterHandler(packet.IDAddActor, c.handleAddActor)
 	c.RegisterHandler(packet.IDRemoveActor, c.handleRemoveActor)
+	c.RegisterHandler(packet.IDMoveActorAbsolute, c.handleMoveActorAbsolute)
+	c.RegisterHandler(packet.IDMoveActorDelta, c.handleMoveActorDelta)
 	c.RegisterHandler(packet.IDLevelChunk, c.handleLevelChunk)
+	c.RegisterHandler(packet.IDSubChunk, c.handleSubChunk)
 
 	// Phase 3: UI and display handlers
 	c.RegisterHandler(packet.IDSetTitle, c.handleSetTitle)
@@ -235,6 +417,21 @@ func (c *Client) registerHandlers() {
 	c.RegisterHandler(packet.IDSetDisplayObjective, c.handleSetDisplayObjective)
 	c.RegisterHandler(packet.IDRemoveObjective, c.handleRemoveObjective)
 	c.RegisterHandler(packet.IDModalFormRequest, c.handleModalFormRequest)
+	c.RegisterHandler(packet.IDPlaySound, c.handlePlaySound)
+	c.RegisterHandler(packet.IDLevelSoundEvent, c.handleLevelSoundEvent)
+	c.RegisterHandler(packet.IDSpawnParticleEffect, c.handleSpawnParticleEffect)
+	c.RegisterHandler(packet.IDPlayerList, c.handlePlayerList)
+	c.RegisterHandler(packet.IDBossEvent, c.handleBossEvent)
+}
+
+// shieldID returns the runtime ID of the shield item, which packet encoding depends on
+func shieldID(gameData minecraft.GameData) int32 {
+	for _, item := range gameData.Items {
+		if item.Name == "minecraft:shield" {
+			return int32(item.RuntimeID)
+		}
+	}
+	return 0
 }
 
 // GetConn returns the underlying minecraft.Conn
@@ -242,10 +439,10 @@ func (c *Client) GetConn() *minecraft.Conn {
 	return c.conn
 }
 
-// generateXUID generates a 16-digit XUID string similar to Xbox Live XUIDs
+// GenerateXUID generates a 16-digit XUID string similar to Xbox Live XUIDs
 // Format: 16 digits (e.g., "2535405290845189")
 // This avoids database length issues (some plugins expect max 20 characters)
-func generateXUID() string {
+func GenerateXUID() string {
 	// Generate a random number between 1000000000000000 and 9999999999999999
 	min := big.NewInt(1000000000000000)
 	max := big.NewInt(9999999999999999)
@@ -264,3 +461,21 @@ func generateXUID() string {
 	n.Add(n, min)
 	return n.String()
 }
+
+// IsSessionConflict reports whether a connection error was caused by the server rejecting the login
+// because a session with the same identity is still active ("Logged in from other location")
+func IsSessionConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	var disconnectErr minecraft.DisconnectError
+	if errors.As(err, &disconnectErr) {
+		msg = string(disconnectErr)
+	}
+
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "logged in from other location") ||
+		strings.Contains(msg, "loggedinotherlocation")
+}
@@ -2,13 +2,65 @@ package protocol
 
 import (
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 
 	"github.com/gollilla/best/pkg/events"
 	"github.com/gollilla/best/pkg/types"
 )
 
+// commandTrackingTTL is how long a tracked command waits for its output before it is forgotten
+// Servers do not answer every CommandRequest, so unanswered entries must not pile up.
+const commandTrackingTTL = time.Minute
+
+// trackedCommand is a CommandRequest awaiting its CommandOutput
+type trackedCommand struct {
+	commandLine string
+	sentAt      time.Time
+}
+
+// TrackCommand remembers the command line of a CommandRequest so that its output can be
+// attributed to it. The server echoes the origin UUID in the CommandOutput packet.
+// Commands still unanswered after commandTrackingTTL are forgotten.
+func (c *Client) TrackCommand(id uuid.UUID, commandLine string) {
+	c.commandsMu.Lock()
+	defer c.commandsMu.Unlock()
+
+	now := time.Now()
+	for origin, cmd := range c.commands {
+		if now.Sub(cmd.sentAt) > commandTrackingTTL {
+			delete(c.commands, origin)
+		}
+	}
+	c.commands[id] = trackedCommand{commandLine: commandLine, sentAt: now}
+}
+
+// UntrackCommand forgets a command tracked with TrackCommand whose output will not be awaited,
+// e.g. because waiting for it timed out
+func (c *Client) UntrackCommand(id uuid.UUID) {
+	c.commandsMu.Lock()
+	defer c.commandsMu.Unlock()
+	delete(c.commands, id)
+}
+
+// takeCommand returns and forgets the command line tracked for the origin UUID
+func (c *Client) takeCommand(id uuid.UUID) string {
+	c.commandsMu.Lock()
+	defer c.commandsMu.Unlock()
+	cmd := c.commands[id]
+	delete(c.commands, id)
+	return cmd.commandLine
+}
+
+// resetCommands forgets all tracked commands; outputs for them never arrive on a new connection
+func (c *Client) resetCommands() {
+	c.commandsMu.Lock()
+	c.commands = make(map[uuid.UUID]trackedCommand)
+	c.commandsMu.Unlock()
+}
+
 // handleCommandOutput handles command execution results
 // Note: Some Bedrock servers (like PNX) send command output via CommandOutput packet
 // while others (like PMMP) send via Text packets
@@ -33,12 +85,37 @@ func (c *Client) handleCommandOutput(pk packet.Packet) {
 		}
 	}
 
+	success := commandSucceeded(p)
+	statusCode := types.CommandStatusSuccess
+	if !success {
+		statusCode = types.CommandStatusFailure
+	}
+
 	output := &types.CommandOutput{
-		Command:    "", // Will be set by the caller
-		Success:    p.SuccessCount > 0,
+		Command:    c.takeCommand(p.CommandOrigin.UUID), // Empty unless sent with TrackCommand
+		Origin:     p.CommandOrigin.UUID.String(),
+		Success:    success,
 		Output:     strings.Join(outputLines, "\n"),
-		StatusCode: int32(p.OutputType),
+		StatusCode: statusCode,
+		OutputType: p.OutputType,
 	}
 
 	c.emitter.Emit(events.EventCommandOutput, output)
 }
+
+// commandSucceeded reports whether a CommandOutput answers a successful command
+// Vanilla sets SuccessCount; other servers often leave it 0 and only flag each output message.
+func commandSucceeded(p *packet.CommandOutput) bool {
+	if p.SuccessCount > 0 {
+		return true
+	}
+	if len(p.OutputMessages) == 0 {
+		return false
+	}
+	for _, msg := range p.OutputMessages {
+		if !msg.Success {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+func TestUntrackCommand(t *testing.T) {
+	c := NewClient(events.NewEmitter(), &types.PlayerState{}, "Bot")
+
+	kept, dropped := uuid.New(), uuid.New()
+	c.TrackCommand(kept, "say kept")
+	c.TrackCommand(dropped, "say dropped")
+	c.UntrackCommand(dropped)
+
+	if len(c.commands) != 1 {
+		t.Errorf("%d command(s) tracked after UntrackCommand, want 1", len(c.commands))
+	}
+	if got := c.takeCommand(dropped); got != "" {
+		t.Errorf("takeCommand(untracked) = %q, want empty", got)
+	}
+	if got := c.takeCommand(kept); got != "say kept" {
+		t.Errorf("takeCommand(kept) = %q, want %q", got, "say kept")
+	}
+}
+
+func TestTrackCommandForgetsExpiredCommands(t *testing.T) {
+	c := NewClient(events.NewEmitter(), &types.PlayerState{}, "Bot")
+
+	stale := uuid.New()
+	c.commands[stale] = trackedCommand{commandLine: "say stale", sentAt: time.Now().Add(-2 * commandTrackingTTL)}
+	fresh := uuid.New()
+	c.TrackCommand(fresh, "say fresh")
+
+	if got := c.takeCommand(stale); got != "" {
+		t.Errorf("takeCommand(stale) = %q, want empty", got)
+	}
+	if got := c.takeCommand(fresh); got != "say fresh" {
+		t.Errorf("takeCommand(fresh) = %q, want %q", got, "say fresh")
+	}
+}
+
+func TestResetCommands(t *testing.T) {
+	c := NewClient(events.NewEmitter(), &types.PlayerState{}, "Bot")
+	c.TrackCommand(uuid.New(), "say before reconnect")
+
+	c.resetCommands()
+
+	if len(c.commands) != 0 {
+		t.Errorf("%d command(s) tracked after reset, want 0", len(c.commands))
+	}
+}
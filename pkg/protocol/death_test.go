@@ -0,0 +1,39 @@
+package protocol
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+func TestDeathInfoAfterHealthIsMerged(t *testing.T) {
+	emitter := events.NewEmitter()
+	c := NewClient(emitter, &types.PlayerState{}, "Bot")
+
+	deaths := 0
+	emitter.OnSync(events.EventDeath, func(_ events.EventData) { deaths++ })
+
+	c.handleSetHealth(&packet.SetHealth{Health: 0})
+	c.handleDeathInfo(&packet.DeathInfo{Cause: "death.attack.mob", Messages: []string{"Zombie"}})
+
+	if deaths != 1 {
+		t.Errorf("EventDeath emitted %d time(s), want 1", deaths)
+	}
+	death, ok := c.LastDeath()
+	if !ok {
+		t.Fatal("LastDeath() reported no death")
+	}
+	if death.Cause != "death.attack.mob" || !reflect.DeepEqual(death.Messages, []string{"Zombie"}) {
+		t.Errorf("LastDeath() = %+v, want the DeathInfo cause merged in", death)
+	}
+
+	// A second DeathInfo in the same life does not overwrite the recorded cause
+	c.handleDeathInfo(&packet.DeathInfo{Cause: "death.attack.generic"})
+	if death, _ := c.LastDeath(); death.Cause != "death.attack.mob" {
+		t.Errorf("LastDeath().Cause = %q, want it unchanged", death.Cause)
+	}
+}
@@ -0,0 +1,52 @@
+package protocol
+
+import "fmt"
+
+// EffectIDToName maps MobEffect effect type IDs to effect names (e.g., 1 -> "minecraft:speed")
+var EffectIDToName = map[int32]string{
+	1:  "minecraft:speed",
+	2:  "minecraft:slowness",
+	3:  "minecraft:haste",
+	4:  "minecraft:mining_fatigue",
+	5:  "minecraft:strength",
+	6:  "minecraft:instant_health",
+	7:  "minecraft:instant_damage",
+	8:  "minecraft:jump_boost",
+	9:  "minecraft:nausea",
+	10: "minecraft:regeneration",
+	11: "minecraft:resistance",
+	12: "minecraft:fire_resistance",
+	13: "minecraft:water_breathing",
+	14: "minecraft:invisibility",
+	15: "minecraft:blindness",
+	16: "minecraft:night_vision",
+	17: "minecraft:hunger",
+	18: "minecraft:weakness",
+	19: "minecraft:poison",
+	20: "minecraft:wither",
+	21: "minecraft:health_boost",
+	22: "minecraft:absorption",
+	23: "minecraft:saturation",
+	24: "minecraft:levitation",
+	25: "minecraft:fatal_poison",
+	26: "minecraft:conduit_power",
+	27: "minecraft:slow_falling",
+	28: "minecraft:bad_omen",
+	29: "minecraft:village_hero",
+	30: "minecraft:darkness",
+	31: "minecraft:trial_omen",
+	32: "minecraft:wind_charged",
+	33: "minecraft:weaving",
+	34: "minecraft:oozing",
+	35: "minecraft:infested",
+	36: "minecraft:raid_omen",
+}
+
+// GetEffectName returns the effect name for a MobEffect effect type ID
+// Unknown IDs (e.g. effects added in newer versions) are returned as "effect:<id>".
+func GetEffectName(effectType int32) string {
+	if name, ok := EffectIDToName[effectType]; ok {
+		return name
+	}
+	return fmt.Sprintf("effect:%d", effectType)
+}
@@ -4,15 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 
 	"github.com/gollilla/best/pkg/events"
 	"github.com/gollilla/best/pkg/types"
+	"github.com/gollilla/best/pkg/world"
 )
 
 // handleUpdateBlock handles block update packets
+// Only the block layer is tracked; updates to the liquid layer (waterlogging) are ignored.
 func (c *Client) handleUpdateBlock(pk packet.Packet) {
 	p := pk.(*packet.UpdateBlock)
+	if p.Layer != 0 {
+		return
+	}
 
 	update := &types.BlockUpdate{
 		Position: types.Position{
@@ -26,6 +32,22 @@ func (c *Client) handleUpdateBlock(pk packet.Packet) {
 	c.emitter.Emit(events.EventBlockUpdate, update)
 }
 
+// handleUpdateSubChunkBlocks handles batched block updates within a sub-chunk
+func (c *Client) handleUpdateSubChunkBlocks(pk packet.Packet) {
+	p := pk.(*packet.UpdateSubChunkBlocks)
+
+	for _, entry := range p.Blocks {
+		c.emitter.Emit(events.EventBlockUpdate, &types.BlockUpdate{
+			Position: types.Position{
+				X: float64(entry.X()),
+				Y: float64(entry.Y()),
+				Z: float64(entry.Z()),
+			},
+			RuntimeID: int32(entry.BlockRuntimeID),
+		})
+	}
+}
+
 // handleInventoryContent handles full inventory updates
 func (c *Client) handleInventoryContent(pk packet.Packet) {
 	p := pk.(*packet.InventoryContent)
@@ -77,6 +99,27 @@ func (c *Client) handleInventorySlot(pk packet.Packet) {
 	c.emitter.Emit(events.EventInventorySlotUpdate, item)
 }
 
+// handleMobEquipment handles held item changes
+// Only the player's own main inventory is tracked; the held item itself is looked up in the inventory.
+func (c *Client) handleMobEquipment(pk packet.Packet) {
+	p := pk.(*packet.MobEquipment)
+
+	if p.EntityRuntimeID != uint64(c.state.RuntimeEntityID) || p.WindowID != protocol.WindowIDInventory {
+		return
+	}
+	c.emitter.Emit(events.EventHeldSlotUpdate, int32(p.HotBarSlot))
+}
+
+// handlePlayerHotBar handles the server selecting a hotbar slot
+func (c *Client) handlePlayerHotBar(pk packet.Packet) {
+	p := pk.(*packet.PlayerHotBar)
+
+	if !p.SelectHotBarSlot || p.WindowID != protocol.WindowIDInventory {
+		return
+	}
+	c.emitter.Emit(events.EventHeldSlotUpdate, int32(p.SelectedHotBarSlot))
+}
+
 // handleMobEffect handles effect application/removal
 func (c *Client) handleMobEffect(pk packet.Packet) {
 	p := pk.(*packet.MobEffect)
@@ -87,7 +130,7 @@ func (c *Client) handleMobEffect(pk packet.Packet) {
 	}
 
 	effect := &types.Effect{
-		ID:        "", // Would need effect ID mapping
+		ID:        GetEffectName(p.EffectType),
 		Amplifier: int32(p.Amplifier),
 		Duration:  int32(p.Duration),
 		Visible:   p.Particles,
@@ -107,6 +150,7 @@ func (c *Client) handleAddActor(pk packet.Packet) {
 
 	entity := &types.Entity{
 		RuntimeID: int64(p.EntityRuntimeID),
+		UniqueID:  p.EntityUniqueID,
 		Type:      p.EntityType,
 		Position: types.Position{
 			X: float64(p.Position.X()),
@@ -125,19 +169,136 @@ func (c *Client) handleRemoveActor(pk packet.Packet) {
 	c.emitter.Emit(events.EventEntityRemove, int64(p.EntityUniqueID))
 }
 
-// handleLevelChunk handles chunk data
+// handleMoveActorAbsolute handles an entity moving to a new position
+func (c *Client) handleMoveActorAbsolute(pk packet.Packet) {
+	p := pk.(*packet.MoveActorAbsolute)
+
+	c.emitter.Emit(events.EventEntityMove, &types.EntityMove{
+		RuntimeID: int64(p.EntityRuntimeID),
+		Position: types.Position{
+			X: float64(p.Position.X()),
+			Y: float64(p.Position.Y()),
+			Z: float64(p.Position.Z()),
+		},
+		HasX: true,
+		HasY: true,
+		HasZ: true,
+	})
+}
+
+// handleMoveActorDelta handles an entity moving, where only the changed coordinates are sent
+func (c *Client) handleMoveActorDelta(pk packet.Packet) {
+	p := pk.(*packet.MoveActorDelta)
+
+	move := &types.EntityMove{
+		RuntimeID: int64(p.EntityRuntimeID),
+		Position: types.Position{
+			X: float64(p.Position.X()),
+			Y: float64(p.Position.Y()),
+			Z: float64(p.Position.Z()),
+		},
+		HasX: p.Flags&packet.MoveActorDeltaFlagHasX != 0,
+		HasY: p.Flags&packet.MoveActorDeltaFlagHasY != 0,
+		HasZ: p.Flags&packet.MoveActorDeltaFlagHasZ != 0,
+	}
+	if !move.HasX && !move.HasY && !move.HasZ {
+		return
+	}
+	c.emitter.Emit(events.EventEntityMove, move)
+}
+
+// handleLevelChunk decodes chunk data and emits the loaded chunk
+// Chunks sent before spawn completes are delivered once the spawn sequence ends, so they are decoded too.
+// Blocks are read from the world rather than emitted one by one; only UpdateBlock emits block updates.
+// Chunks sent with the blob cache are not supported and stay unloaded.
 func (c *Client) handleLevelChunk(pk packet.Packet) {
-	// p := pk.(*packet.LevelChunk)
+	p := pk.(*packet.LevelChunk)
+
+	// With the blob cache the payload only holds blob hashes
+	if p.CacheEnabled {
+		return
+	}
+
+	// In sub-chunk request mode the payload holds no sub-chunks (only biomes, border blocks and
+	// block entities): the column is loaded empty and its sub-chunks are requested
+	if p.SubChunkCount == protocol.SubChunkRequestModeLimited ||
+		p.SubChunkCount == protocol.SubChunkRequestModeLimitless {
+		chunkPos := world.ChunkPos{X: p.Position.X(), Z: p.Position.Z()}
+		c.emitter.Emit(events.EventChunkLoaded, &world.Chunk{Position: chunkPos})
+		c.requestSubChunks(p.Dimension, chunkPos)
+		return
+	}
+
+	chunk, err := world.DecodeChunk(p.RawPayload, int(p.SubChunkCount), p.Position.X(), p.Position.Z(), world.MinSubChunk(p.Dimension))
+	if err != nil {
+		fmt.Printf("[WARN] Failed to decode chunk: %v\n", err)
+		return
+	}
 
-	// TODO: Implement chunk decoding
-	// This is complex and requires:
-	// 1. Parsing sub-chunk count
-	// 2. Decoding palettes for each sub-chunk
-	// 3. Decompressing and reading block data
-	// 4. Updating the world state
+	c.emitter.Emit(events.EventChunkLoaded, chunk)
+}
 
-	// For now, we just acknowledge receipt
-	// Full implementation would decode and store chunk data
+// requestSubChunks asks the server for every sub-chunk of a chunk column
+func (c *Client) requestSubChunks(dimension int32, chunkPos world.ChunkPos) {
+	count := world.SubChunkCount(dimension)
+	offsets := make([]protocol.SubChunkOffset, count)
+	for i := range offsets {
+		offsets[i] = protocol.SubChunkOffset{0, int8(i), 0}
+	}
+
+	// Replays have no connection to request from; recorded SubChunk packets are replayed instead
+	_ = c.WritePacket(&packet.SubChunkRequest{
+		Dimension: dimension,
+		Position:  protocol.SubChunkPos{chunkPos.X, int32(world.MinSubChunk(dimension)), chunkPos.Z},
+		Offsets:   offsets,
+	})
+}
+
+// handleSubChunk decodes sub-chunks sent in response to sub-chunk requests
+// Entries are grouped by chunk column and emitted as partial chunks. Entries sent with the blob
+// cache or with an error result are skipped.
+func (c *Client) handleSubChunk(pk packet.Packet) {
+	p := pk.(*packet.SubChunk)
+	if p.CacheEnabled {
+		return
+	}
+
+	chunks := make(map[world.ChunkPos]*world.Chunk)
+	var order []world.ChunkPos
+	for _, entry := range p.SubChunkEntries {
+		chunkPos := world.ChunkPos{
+			X: p.Position.X() + int32(entry.Offset[0]),
+			Z: p.Position.Z() + int32(entry.Offset[2]),
+		}
+		y := int8(p.Position.Y() + int32(entry.Offset[1]))
+
+		var subChunk *world.SubChunk
+		switch entry.Result {
+		case protocol.SubChunkResultSuccess:
+			decoded, err := world.DecodeSubChunk(entry.RawPayload, y)
+			if err != nil {
+				fmt.Printf("[WARN] Failed to decode sub-chunk %d of chunk (%d, %d): %v\n", y, chunkPos.X, chunkPos.Z, err)
+				continue
+			}
+			subChunk = decoded
+		case protocol.SubChunkResultSuccessAllAir:
+			subChunk = &world.SubChunk{Y: y}
+		default:
+			continue
+		}
+
+		chunk, ok := chunks[chunkPos]
+		if !ok {
+			chunk = &world.Chunk{Position: chunkPos}
+			chunks[chunkPos] = chunk
+			order = append(order, chunkPos)
+		}
+		chunk.SubChunks = append(chunk.SubChunks, subChunk)
+	}
+
+	for _, chunkPos := range order {
+		c.emitter.Emit(events.EventSubChunkLoaded, chunks[chunkPos])
+	}
 }
 
 // handleSetTitle handles title/subtitle/actionbar display
@@ -431,3 +592,38 @@ func (c *Client) handleModalFormRequest(pk packet.Packet) {
 
 	c.emitter.Emit(events.EventForm, form)
 }
+
+// handlePlaySound handles named sounds, e.g. from /playsound
+func (c *Client) handlePlaySound(pk packet.Packet) {
+	p := pk.(*packet.PlaySound)
+
+	c.emitter.Emit(events.EventSound, &types.SoundPlay{
+		Name:     p.SoundName,
+		Position: types.Position{X: float64(p.Position.X()), Y: float64(p.Position.Y()), Z: float64(p.Position.Z())},
+		Volume:   p.Volume,
+		Pitch:    p.Pitch,
+	})
+}
+
+// handleLevelSoundEvent handles built-in sound events, named with GetSoundEventName
+// These carry no volume or pitch, so both are reported as 1.
+func (c *Client) handleLevelSoundEvent(pk packet.Packet) {
+	p := pk.(*packet.LevelSoundEvent)
+
+	c.emitter.Emit(events.EventSound, &types.SoundPlay{
+		Name:     GetSoundEventName(p.SoundType),
+		Position: types.Position{X: float64(p.Position.X()), Y: float64(p.Position.Y()), Z: float64(p.Position.Z())},
+		Volume:   1,
+		Pitch:    1,
+	})
+}
+
+// handleSpawnParticleEffect handles named particle effects, e.g. from /particle
+func (c *Client) handleSpawnParticleEffect(pk packet.Packet) {
+	p := pk.(*packet.SpawnParticleEffect)
+
+	c.emitter.Emit(events.EventParticle, &types.ParticleSpawn{
+		Name:     p.ParticleName,
+		Position: types.Position{X: float64(p.Position.X()), Y: float64(p.Position.Y()), Z: float64(p.Position.Z())},
+	})
+}
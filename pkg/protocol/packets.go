@@ -29,6 +29,14 @@ func (c *Client) handleText(pk packet.Packet) {
 		Message:   message,
 		Timestamp: 0, // Will be set by caller if needed
 		XUID:      p.XUID,
+		Recipient: c.identifier,
+	}
+
+	// Keep the locale-independent key so assertions do not depend on the server language
+	if p.TextType == packet.TextTypeTranslation || p.NeedsTranslation {
+		msg.TranslationKey = translationKey(p.Message)
+		msg.Parameters = p.Parameters
+		c.handlePlayerListMessage(msg)
 	}
 
 	c.emitter.Emit(events.EventChat, msg)
@@ -52,9 +60,35 @@ func (c *Client) handleMovePlayer(pk packet.Packet) {
 		c.state.IsOnGround = p.OnGround
 
 		c.emitter.Emit(events.EventPositionUpdate, c.state.Position)
+
+		// MoveModeReset is sent when the server rejects our movement (e.g. anti-cheat)
+		if p.Mode == packet.MoveModeReset {
+			c.emitter.Emit(events.EventMoveCorrected, &types.MoveCorrection{
+				Position: c.state.Position,
+				Source:   "move_player",
+			})
+		}
 	}
 }
 
+// handleCorrectPlayerMovePrediction handles server corrections of client-predicted movement
+func (c *Client) handleCorrectPlayerMovePrediction(pk packet.Packet) {
+	p := pk.(*packet.CorrectPlayerMovePrediction)
+
+	c.state.Position = types.Position{
+		X: float64(p.Position.X()),
+		Y: float64(p.Position.Y()),
+		Z: float64(p.Position.Z()),
+	}
+	c.state.IsOnGround = p.OnGround
+
+	c.emitter.Emit(events.EventPositionUpdate, c.state.Position)
+	c.emitter.Emit(events.EventMoveCorrected, &types.MoveCorrection{
+		Position: c.state.Position,
+		Source:   "correct_prediction",
+	})
+}
+
 // handleStartGame handles the initial game start
 // Note: Initial state is extracted from GameData in Connect(), this handler is for reconnections
 func (c *Client) handleStartGame(pk packet.Packet) {
@@ -68,7 +102,11 @@ func (c *Client) handleStartGame(pk packet.Packet) {
 	}
 	c.state.Gamemode = p.PlayerGameMode
 	c.state.PermissionLevel = int32(p.PlayerPermissions)
+	c.state.Time = int32(p.Time)
 	c.emitter.Emit(events.EventPermissionUpdate, int32(p.PlayerPermissions))
+
+	c.raining, c.thundering = p.RainLevel > 0, p.LightningLevel > 0
+	c.updateWeather()
 }
 
 // handleUpdateAttributes handles attribute updates (health, hunger, etc.)
@@ -76,15 +114,158 @@ func (c *Client) handleUpdateAttributes(pk packet.Packet) {
 	p := pk.(*packet.UpdateAttributes)
 
 	if p.EntityRuntimeID == uint64(c.state.RuntimeEntityID) {
+		experienceChanged := false
 		for _, attr := range p.Attributes {
 			switch attr.Name {
 			case "minecraft:health":
 				c.state.Health = attr.Value
+				if attr.Max > 0 {
+					c.state.MaxHealth = attr.Max
+				}
 				c.emitter.Emit(events.EventHealthUpdate, attr.Value)
+				if attr.Value <= 0 {
+					c.markDead(&types.Death{Position: c.state.Position})
+				}
 			case "minecraft:player.hunger":
 				c.emitter.Emit(events.EventHungerUpdate, attr.Value)
+			case "minecraft:player.level":
+				c.state.Experience.Level = int32(attr.Value)
+				experienceChanged = true
+			case "minecraft:player.experience":
+				c.state.Experience.Progress = attr.Value
+				experienceChanged = true
 			}
 		}
+		// Level and progress usually arrive together; report them once
+		if experienceChanged {
+			c.emitter.Emit(events.EventExperienceUpdate, c.state.Experience)
+		}
+	}
+}
+
+// handleSetHealth handles the legacy health packet
+func (c *Client) handleSetHealth(pk packet.Packet) {
+	p := pk.(*packet.SetHealth)
+
+	c.state.Health = float32(p.Health)
+	c.emitter.Emit(events.EventHealthUpdate, float32(p.Health))
+	if p.Health <= 0 {
+		c.markDead(&types.Death{Position: c.state.Position})
+	}
+}
+
+// handleDeathInfo handles the death screen information sent when the player dies
+func (c *Client) handleDeathInfo(pk packet.Packet) {
+	p := pk.(*packet.DeathInfo)
+
+	c.markDead(&types.Death{
+		Cause:    p.Cause,
+		Messages: p.Messages,
+		Position: c.state.Position,
+	})
+}
+
+// markDead emits EventDeath once per life
+// Servers report a death through health 0 and DeathInfo, in either order. When health 0 comes
+// first, the later DeathInfo is merged into the recorded death instead of being dropped.
+func (c *Client) markDead(death *types.Death) {
+	c.lastDeathMu.Lock()
+	if c.dead.Swap(true) {
+		if c.lastDeath != nil && c.lastDeath.Cause == "" && death.Cause != "" {
+			merged := *c.lastDeath
+			merged.Cause = death.Cause
+			merged.Messages = death.Messages
+			c.lastDeath = &merged
+		}
+		c.lastDeathMu.Unlock()
+		return
+	}
+	c.lastDeath = death
+	c.lastDeathMu.Unlock()
+
+	c.emitter.Emit(events.EventDeath, death)
+}
+
+// LastDeath returns the most recent death since connecting
+// Its Cause and Messages include a DeathInfo received after the death was first reported.
+func (c *Client) LastDeath() (types.Death, bool) {
+	c.lastDeathMu.Lock()
+	defer c.lastDeathMu.Unlock()
+	if c.lastDeath == nil {
+		return types.Death{}, false
+	}
+	return *c.lastDeath, true
+}
+
+// resetDeath forgets the death state of a previous connection
+func (c *Client) resetDeath() {
+	c.lastDeathMu.Lock()
+	defer c.lastDeathMu.Unlock()
+	c.dead.Store(false)
+	c.lastDeath = nil
+}
+
+// handleRespawn handles the server's respawn position
+// Only the "ready to spawn" state completes a respawn; it moves the player and ends the death.
+func (c *Client) handleRespawn(pk packet.Packet) {
+	p := pk.(*packet.Respawn)
+
+	if p.State != packet.RespawnStateReadyToSpawn {
+		return
+	}
+
+	pos := types.Position{
+		X: float64(p.Position.X()),
+		Y: float64(p.Position.Y()),
+		Z: float64(p.Position.Z()),
+	}
+	c.state.Position = pos
+	c.lastDeathMu.Lock()
+	c.dead.Store(false)
+	c.lastDeathMu.Unlock()
+	c.emitter.Emit(events.EventPositionUpdate, pos)
+	c.emitter.Emit(events.EventRespawn, pos)
+}
+
+// handleSetTime handles world time updates
+func (c *Client) handleSetTime(pk packet.Packet) {
+	p := pk.(*packet.SetTime)
+
+	c.state.Time = p.Time
+	c.emitter.Emit(events.EventTimeUpdate, p.Time)
+}
+
+// handleLevelEvent handles level events; only weather changes are tracked
+func (c *Client) handleLevelEvent(pk packet.Packet) {
+	p := pk.(*packet.LevelEvent)
+
+	switch p.EventType {
+	case packet.LevelEventStartRaining:
+		c.raining = true
+	case packet.LevelEventStopRaining:
+		c.raining = false
+	case packet.LevelEventStartThunderstorm:
+		c.thundering = true
+	case packet.LevelEventStopThunderstorm:
+		c.thundering = false
+	default:
+		return
+	}
+	c.updateWeather()
+}
+
+// updateWeather derives state.Weather from the weather flags and emits EventWeatherUpdate on change
+func (c *Client) updateWeather() {
+	weather := types.WeatherClear
+	if c.thundering {
+		weather = types.WeatherThunder
+	} else if c.raining {
+		weather = types.WeatherRain
+	}
+
+	if c.state.Weather != weather {
+		c.state.Weather = weather
+		c.emitter.Emit(events.EventWeatherUpdate, weather)
 	}
 }
 
@@ -118,6 +299,21 @@ func (c *Client) handleDisconnect(pk packet.Packet) {
 	c.emitter.Emit(events.EventDisconnect, p.Message)
 }
 
+// translationKey extracts the translation key from a message such as "§e%multiplayer.player.joined"
+func translationKey(message string) string {
+	var b strings.Builder
+	runes := []rune(message)
+	for i := 0; i < len(runes); i++ {
+		// Skip formatting codes (§ followed by one character)
+		if runes[i] == '§' {
+			i++
+			continue
+		}
+		b.WriteRune(runes[i])
+	}
+	return strings.TrimPrefix(strings.TrimSpace(b.String()), "%")
+}
+
 // mapTextType maps packet text types to our string types
 func mapTextType(textType byte) string {
 	switch textType {
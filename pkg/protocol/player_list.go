@@ -0,0 +1,160 @@
+package protocol
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// Translation keys of the vanilla join and leave messages
+const (
+	translationPlayerJoined = "multiplayer.player.joined"
+	translationPlayerLeft   = "multiplayer.player.left"
+)
+
+// handlePlayerList tracks the server's player list and emits join/leave events
+func (c *Client) handlePlayerList(pk packet.Packet) {
+	p := pk.(*packet.PlayerList)
+
+	for _, entry := range p.Entries {
+		key := entry.UUID.String()
+		if p.ActionType == packet.PlayerListActionAdd {
+			c.playerJoined(key, types.PlayerListEntry{
+				UUID:           key,
+				Name:           entry.Username,
+				XUID:           entry.XUID,
+				EntityUniqueID: entry.EntityUniqueID,
+			})
+		} else {
+			c.playerLeft(key)
+		}
+	}
+}
+
+// handlePlayerListMessage derives join/leave events from the vanilla join and leave messages
+// Servers that do not send a player list still produce these messages.
+func (c *Client) handlePlayerListMessage(msg *types.ChatMessage) {
+	if len(msg.Parameters) == 0 {
+		return
+	}
+	name := msg.Parameters[0]
+
+	switch msg.TranslationKey {
+	case translationPlayerJoined:
+		c.playerJoined("name:"+name, types.PlayerListEntry{Name: name})
+	case translationPlayerLeft:
+		c.playersMu.Lock()
+		key, ok := c.findPlayer(name)
+		c.playersMu.Unlock()
+		if ok {
+			c.playerLeft(key)
+		}
+	}
+}
+
+// playerJoined adds a player and emits EventPlayerJoin unless a player with that name is already listed
+// An entry added from a join message is replaced by the player list entry without a second event,
+// and so is the entry of a player who rejoined before the server removed the old one.
+func (c *Client) playerJoined(key string, entry types.PlayerListEntry) {
+	c.playersMu.Lock()
+	if existing, ok := c.findPlayer(entry.Name); ok {
+		replaced := entry.UUID != "" && existing != key
+		if replaced {
+			delete(c.players, existing)
+			c.players[key] = &entry
+		}
+		c.playersMu.Unlock()
+
+		if replaced {
+			c.emitter.Emit(events.EventPlayerListUpdate, c.PlayerList())
+		}
+		return
+	}
+	c.players[key] = &entry
+	c.playersMu.Unlock()
+
+	c.emitter.Emit(events.EventPlayerJoin, &entry)
+	c.emitter.Emit(events.EventPlayerListUpdate, c.PlayerList())
+}
+
+// playerLeft removes a player and emits EventPlayerLeave if it was listed
+func (c *Client) playerLeft(key string) {
+	c.playersMu.Lock()
+	entry, ok := c.players[key]
+	delete(c.players, key)
+	c.playersMu.Unlock()
+
+	if ok {
+		c.emitter.Emit(events.EventPlayerLeave, entry)
+		c.emitter.Emit(events.EventPlayerListUpdate, c.PlayerList())
+	}
+}
+
+// findPlayer returns the key of the listed player with the given name
+// playersMu must be held.
+func (c *Client) findPlayer(name string) (string, bool) {
+	for key, entry := range c.players {
+		if entry.Name == name {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// resetPlayerList clears the tracked player list
+func (c *Client) resetPlayerList() {
+	c.playersMu.Lock()
+	c.players = make(map[string]*types.PlayerListEntry)
+	c.playersMu.Unlock()
+}
+
+// PlayerList returns the players currently in the server's player list, sorted by name
+func (c *Client) PlayerList() []types.PlayerListEntry {
+	c.playersMu.Lock()
+	defer c.playersMu.Unlock()
+
+	list := make([]types.PlayerListEntry, 0, len(c.players))
+	for _, entry := range c.players {
+		list = append(list, *entry)
+	}
+	slices.SortFunc(list, func(x, y types.PlayerListEntry) int {
+		return strings.Compare(x.Name, y.Name)
+	})
+	return list
+}
+
+// OwnPlayerListEntry returns the agent's own entry in the server's player list
+// The entry is matched by entity unique ID, login identity UUID or XUID, since servers may
+// rename the player (e.g. suffixing duplicate names) or derive the UUID from the XUID.
+func (c *Client) OwnPlayerListEntry() (types.PlayerListEntry, bool) {
+	if c.conn == nil {
+		return types.PlayerListEntry{}, false
+	}
+	uniqueID := c.conn.GameData().EntityUniqueID
+	identity := c.conn.IdentityData()
+
+	c.playersMu.Lock()
+	defer c.playersMu.Unlock()
+
+	var match *types.PlayerListEntry
+	for _, entry := range c.players {
+		if entry.UUID == "" {
+			// Derived from a join message, which carries only the name
+			continue
+		}
+		if entry.EntityUniqueID == uniqueID {
+			return *entry, true
+		}
+		if entry.UUID == identity.Identity || (identity.XUID != "" && entry.XUID == identity.XUID) {
+			match = entry
+		}
+	}
+	if match == nil {
+		return types.PlayerListEntry{}, false
+	}
+	return *match, true
+}
@@ -0,0 +1,242 @@
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+// sessionMagic identifies a recorded session file
+const sessionMagic = "BESTSESSION1\n"
+
+// SessionInfo is the connection state captured at the start of a recorded session
+// Packets received during login (such as StartGame) are not recorded, so the
+// state they carry is stored here instead.
+type SessionInfo struct {
+	Username        string         `json:"username"`
+	RuntimeEntityID int64          `json:"runtimeEntityId"`
+	Position        types.Position `json:"position"`
+	Gamemode        int32          `json:"gamemode"`
+	PermissionLevel int32          `json:"permissionLevel"`
+	ShieldID        int32          `json:"shieldId"`
+	HashedBlockIDs  bool           `json:"hashedBlockIds,omitempty"`
+}
+
+// SessionRecorder writes received packets to a session file
+//
+// File layout: magic, uint32 length + JSON SessionInfo, then one record per packet:
+// int64 nanoseconds since the session started, uint32 packet ID, uint32 length, payload.
+type SessionRecorder struct {
+	mu       sync.Mutex
+	file     *os.File
+	w        *bufio.Writer
+	start    time.Time
+	shieldID int32
+}
+
+// NewSessionRecorder creates a session file at path and writes the session header
+func NewSessionRecorder(path string, info SessionInfo) (*SessionRecorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session file: %w", err)
+	}
+
+	header, err := json.Marshal(info)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to encode session info: %w", err)
+	}
+
+	w := bufio.NewWriter(file)
+	w.WriteString(sessionMagic)
+	binary.Write(w, binary.LittleEndian, uint32(len(header)))
+	w.Write(header)
+
+	return &SessionRecorder{
+		file:     file,
+		w:        w,
+		start:    time.Now(),
+		shieldID: info.ShieldID,
+	}, nil
+}
+
+// AppendSessionRecorder reopens a session file written by NewSessionRecorder to record more packets
+// start is the start time of the original recording, so timestamps keep increasing across reconnects.
+// The header is not rewritten: replays use the state of the first connection.
+func AppendSessionRecorder(path string, start time.Time, shieldID int32) (*SessionRecorder, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+
+	return &SessionRecorder{
+		file:     file,
+		w:        bufio.NewWriter(file),
+		start:    start,
+		shieldID: shieldID,
+	}, nil
+}
+
+// Start returns the time the recording started
+func (r *SessionRecorder) Start() time.Time {
+	return r.start
+}
+
+// Record appends a packet to the session file
+func (r *SessionRecorder) Record(pk packet.Packet) error {
+	buf := bytes.NewBuffer(nil)
+	pk.Marshal(protocol.NewWriter(buf, r.shieldID))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := binary.Write(r.w, binary.LittleEndian, int64(time.Since(r.start))); err != nil {
+		return err
+	}
+	if err := binary.Write(r.w, binary.LittleEndian, pk.ID()); err != nil {
+		return err
+	}
+	if err := binary.Write(r.w, binary.LittleEndian, uint32(buf.Len())); err != nil {
+		return err
+	}
+	_, err := r.w.Write(buf.Bytes())
+	return err
+}
+
+// Close flushes and closes the session file
+func (r *SessionRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.w.Flush(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// SessionReader reads packets back from a session file
+type SessionReader struct {
+	file *os.File
+	r    *bufio.Reader
+	info SessionInfo
+	pool packet.Pool
+}
+
+// OpenSession opens a session file written by SessionRecorder
+func OpenSession(path string) (*SessionReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+
+	r := bufio.NewReader(file)
+	magic := make([]byte, len(sessionMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != sessionMagic {
+		file.Close()
+		return nil, fmt.Errorf("not a session file: %s", path)
+	}
+
+	var headerLen uint32
+	if err := binary.Read(r, binary.LittleEndian, &headerLen); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read session header: %w", err)
+	}
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read session header: %w", err)
+	}
+
+	var info SessionInfo
+	if err := json.Unmarshal(header, &info); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to decode session header: %w", err)
+	}
+
+	return &SessionReader{
+		file: file,
+		r:    r,
+		info: info,
+		pool: packet.NewServerPool(),
+	}, nil
+}
+
+// Info returns the session header
+func (s *SessionReader) Info() SessionInfo {
+	return s.info
+}
+
+// Next returns the next recorded packet and the time it was received relative to the session start
+// It returns io.EOF after the last packet. Packets that cannot be decoded are logged and skipped.
+func (s *SessionReader) Next() (time.Duration, packet.Packet, error) {
+	for {
+		elapsed, id, payload, err := s.next()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		pk, err := s.decode(id, payload)
+		if err != nil {
+			fmt.Printf("[WARN] Skipping recorded packet: %v\n", err)
+			continue
+		}
+		return elapsed, pk, nil
+	}
+}
+
+// next reads the next packet record
+func (s *SessionReader) next() (time.Duration, uint32, []byte, error) {
+	var elapsed int64
+	if err := binary.Read(s.r, binary.LittleEndian, &elapsed); err != nil {
+		return 0, 0, nil, err
+	}
+	var id, length uint32
+	if err := binary.Read(s.r, binary.LittleEndian, &id); err != nil {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	if err := binary.Read(s.r, binary.LittleEndian, &length); err != nil {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(s.r, payload); err != nil {
+		return 0, 0, nil, io.ErrUnexpectedEOF
+	}
+	return time.Duration(elapsed), id, payload, nil
+}
+
+// decode decodes a packet payload, falling back to packet.Unknown for unsupported IDs
+func (s *SessionReader) decode(id uint32, payload []byte) (pk packet.Packet, err error) {
+	newPacket, ok := s.pool[id]
+	if !ok {
+		return &packet.Unknown{PacketID: id, Payload: payload}, nil
+	}
+
+	// protocol.Reader panics on malformed data
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("failed to decode packet %d: %v", id, recovered)
+		}
+	}()
+
+	// protocol.Reader needs a *bytes.Buffer: other readers fail on empty strings
+	pk = newPacket()
+	pk.Marshal(protocol.NewReader(bytes.NewBuffer(payload), s.info.ShieldID, false))
+	return pk, nil
+}
+
+// Close closes the session file
+func (s *SessionReader) Close() error {
+	return s.file.Close()
+}
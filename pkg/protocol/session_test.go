@@ -0,0 +1,74 @@
+package protocol
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+func TestSessionRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.bin")
+
+	recorder, err := NewSessionRecorder(path, SessionInfo{Username: "Bot", RuntimeEntityID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Empty strings used to break decoding
+	if err := recorder.Record(&packet.SetTitle{ActionType: packet.TitleActionSetTitle, Text: "Welcome"}); err != nil {
+		t.Fatal(err)
+	}
+	// A record that cannot be decoded is skipped on replay
+	if err := recorder.Record(&packet.Unknown{PacketID: packet.IDSetTitle, Payload: []byte{0xff}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A reconnect appends to the same file
+	recorder, err = AppendSessionRecorder(path, recorder.Start(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Record(&packet.Text{TextType: packet.TextTypeRaw, Message: "after reconnect"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := OpenSession(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Close()
+
+	if info := session.Info(); info.Username != "Bot" || info.RuntimeEntityID != 7 {
+		t.Errorf("Info() = %+v", info)
+	}
+
+	var packets []packet.Packet
+	for {
+		_, pk, err := session.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		packets = append(packets, pk)
+	}
+
+	if len(packets) != 2 {
+		t.Fatalf("replayed %d packets, want 2", len(packets))
+	}
+	if title, ok := packets[0].(*packet.SetTitle); !ok || title.Text != "Welcome" {
+		t.Errorf("packet 0 = %#v, want SetTitle \"Welcome\"", packets[0])
+	}
+	if text, ok := packets[1].(*packet.Text); !ok || text.Message != "after reconnect" {
+		t.Errorf("packet 1 = %#v, want Text \"after reconnect\"", packets[1])
+	}
+}
@@ -0,0 +1,579 @@
+package protocol
+
+import (
+	"fmt"
+
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+// SoundEventToName maps LevelSoundEvent sound types to names derived from gophertunnel's constants
+// (e.g., packet.SoundEventLevelUp -> "level_up")
+var SoundEventToName = map[uint32]string{
+	packet.SoundEventItemUseOn:                          "item_use_on",
+	packet.SoundEventHit:                                "hit",
+	packet.SoundEventStep:                               "step",
+	packet.SoundEventFly:                                "fly",
+	packet.SoundEventJump:                               "jump",
+	packet.SoundEventBreak:                              "break",
+	packet.SoundEventPlace:                              "place",
+	packet.SoundEventHeavyStep:                          "heavy_step",
+	packet.SoundEventGallop:                             "gallop",
+	packet.SoundEventFall:                               "fall",
+	packet.SoundEventAmbient:                            "ambient",
+	packet.SoundEventAmbientBaby:                        "ambient_baby",
+	packet.SoundEventAmbientInWater:                     "ambient_in_water",
+	packet.SoundEventBreathe:                            "breathe",
+	packet.SoundEventDeath:                              "death",
+	packet.SoundEventDeathInWater:                       "death_in_water",
+	packet.SoundEventDeathToZombie:                      "death_to_zombie",
+	packet.SoundEventHurt:                               "hurt",
+	packet.SoundEventHurtInWater:                        "hurt_in_water",
+	packet.SoundEventMad:                                "mad",
+	packet.SoundEventBoost:                              "boost",
+	packet.SoundEventBow:                                "bow",
+	packet.SoundEventSquishBig:                          "squish_big",
+	packet.SoundEventSquishSmall:                        "squish_small",
+	packet.SoundEventFallBig:                            "fall_big",
+	packet.SoundEventFallSmall:                          "fall_small",
+	packet.SoundEventSplash:                             "splash",
+	packet.SoundEventFizz:                               "fizz",
+	packet.SoundEventFlap:                               "flap",
+	packet.SoundEventSwim:                               "swim",
+	packet.SoundEventDrink:                              "drink",
+	packet.SoundEventEat:                                "eat",
+	packet.SoundEventTakeoff:                            "takeoff",
+	packet.SoundEventShake:                              "shake",
+	packet.SoundEventPlop:                               "plop",
+	packet.SoundEventLand:                               "land",
+	packet.SoundEventSaddle:                             "saddle",
+	packet.SoundEventArmor:                              "armor",
+	packet.SoundEventArmorPlace:                         "armor_place",
+	packet.SoundEventAddChest:                           "add_chest",
+	packet.SoundEventThrow:                              "throw",
+	packet.SoundEventAttack:                             "attack",
+	packet.SoundEventAttackNoDamage:                     "attack_no_damage",
+	packet.SoundEventAttackStrong:                       "attack_strong",
+	packet.SoundEventWarn:                               "warn",
+	packet.SoundEventShear:                              "shear",
+	packet.SoundEventMilk:                               "milk",
+	packet.SoundEventThunder:                            "thunder",
+	packet.SoundEventExplode:                            "explode",
+	packet.SoundEventFire:                               "fire",
+	packet.SoundEventIgnite:                             "ignite",
+	packet.SoundEventFuse:                               "fuse",
+	packet.SoundEventStare:                              "stare",
+	packet.SoundEventSpawn:                              "spawn",
+	packet.SoundEventShoot:                              "shoot",
+	packet.SoundEventBreakBlock:                         "break_block",
+	packet.SoundEventLaunch:                             "launch",
+	packet.SoundEventBlast:                              "blast",
+	packet.SoundEventLargeBlast:                         "large_blast",
+	packet.SoundEventTwinkle:                            "twinkle",
+	packet.SoundEventRemedy:                             "remedy",
+	packet.SoundEventUnfect:                             "unfect",
+	packet.SoundEventLevelUp:                            "level_up",
+	packet.SoundEventBowHit:                             "bow_hit",
+	packet.SoundEventBulletHit:                          "bullet_hit",
+	packet.SoundEventExtinguishFire:                     "extinguish_fire",
+	packet.SoundEventItemFizz:                           "item_fizz",
+	packet.SoundEventChestOpen:                          "chest_open",
+	packet.SoundEventChestClosed:                        "chest_closed",
+	packet.SoundEventShulkerBoxOpen:                     "shulker_box_open",
+	packet.SoundEventShulkerBoxClosed:                   "shulker_box_closed",
+	packet.SoundEventEnderChestOpen:                     "ender_chest_open",
+	packet.SoundEventEnderChestClosed:                   "ender_chest_closed",
+	packet.SoundEventPowerOn:                            "power_on",
+	packet.SoundEventPowerOff:                           "power_off",
+	packet.SoundEventAttach:                             "attach",
+	packet.SoundEventDetach:                             "detach",
+	packet.SoundEventDeny:                               "deny",
+	packet.SoundEventTripod:                             "tripod",
+	packet.SoundEventPop:                                "pop",
+	packet.SoundEventDropSlot:                           "drop_slot",
+	packet.SoundEventNote:                               "note",
+	packet.SoundEventThorns:                             "thorns",
+	packet.SoundEventPistonIn:                           "piston_in",
+	packet.SoundEventPistonOut:                          "piston_out",
+	packet.SoundEventPortal:                             "portal",
+	packet.SoundEventWater:                              "water",
+	packet.SoundEventLavaPop:                            "lava_pop",
+	packet.SoundEventLava:                               "lava",
+	packet.SoundEventBurp:                               "burp",
+	packet.SoundEventBucketFillWater:                    "bucket_fill_water",
+	packet.SoundEventBucketFillLava:                     "bucket_fill_lava",
+	packet.SoundEventBucketEmptyWater:                   "bucket_empty_water",
+	packet.SoundEventBucketEmptyLava:                    "bucket_empty_lava",
+	packet.SoundEventEquipChain:                         "equip_chain",
+	packet.SoundEventEquipDiamond:                       "equip_diamond",
+	packet.SoundEventEquipGeneric:                       "equip_generic",
+	packet.SoundEventEquipGold:                          "equip_gold",
+	packet.SoundEventEquipIron:                          "equip_iron",
+	packet.SoundEventEquipLeather:                       "equip_leather",
+	packet.SoundEventEquipElytra:                        "equip_elytra",
+	packet.SoundEventRecord13:                           "record13",
+	packet.SoundEventRecordCat:                          "record_cat",
+	packet.SoundEventRecordBlocks:                       "record_blocks",
+	packet.SoundEventRecordChirp:                        "record_chirp",
+	packet.SoundEventRecordFar:                          "record_far",
+	packet.SoundEventRecordMall:                         "record_mall",
+	packet.SoundEventRecordMellohi:                      "record_mellohi",
+	packet.SoundEventRecordStal:                         "record_stal",
+	packet.SoundEventRecordStrad:                        "record_strad",
+	packet.SoundEventRecordWard:                         "record_ward",
+	packet.SoundEventRecord11:                           "record11",
+	packet.SoundEventRecordWait:                         "record_wait",
+	packet.SoundEventRecordNull:                         "record_null",
+	packet.SoundEventFlop:                               "flop",
+	packet.SoundEventGuardianCurse:                      "guardian_curse",
+	packet.SoundEventMobWarning:                         "mob_warning",
+	packet.SoundEventMobWarningBaby:                     "mob_warning_baby",
+	packet.SoundEventTeleport:                           "teleport",
+	packet.SoundEventShulkerOpen:                        "shulker_open",
+	packet.SoundEventShulkerClose:                       "shulker_close",
+	packet.SoundEventHaggle:                             "haggle",
+	packet.SoundEventHaggleYes:                          "haggle_yes",
+	packet.SoundEventHaggleNo:                           "haggle_no",
+	packet.SoundEventHaggleIdle:                         "haggle_idle",
+	packet.SoundEventChorusGrow:                         "chorus_grow",
+	packet.SoundEventChorusDeath:                        "chorus_death",
+	packet.SoundEventGlass:                              "glass",
+	packet.SoundEventPotionBrewed:                       "potion_brewed",
+	packet.SoundEventCastSpell:                          "cast_spell",
+	packet.SoundEventPrepareAttackSpell:                 "prepare_attack_spell",
+	packet.SoundEventPrepareSummon:                      "prepare_summon",
+	packet.SoundEventPrepareWololo:                      "prepare_wololo",
+	packet.SoundEventFang:                               "fang",
+	packet.SoundEventCharge:                             "charge",
+	packet.SoundEventTakePicture:                        "take_picture",
+	packet.SoundEventPlaceLeashKnot:                     "place_leash_knot",
+	packet.SoundEventBreakLeashKnot:                     "break_leash_knot",
+	packet.SoundEventAmbientGrowl:                       "ambient_growl",
+	packet.SoundEventAmbientWhine:                       "ambient_whine",
+	packet.SoundEventAmbientPant:                        "ambient_pant",
+	packet.SoundEventAmbientPurr:                        "ambient_purr",
+	packet.SoundEventAmbientPurreow:                     "ambient_purreow",
+	packet.SoundEventDeathMinVolume:                     "death_min_volume",
+	packet.SoundEventDeathMidVolume:                     "death_mid_volume",
+	packet.SoundEventImitateBlaze:                       "imitate_blaze",
+	packet.SoundEventImitateCaveSpider:                  "imitate_cave_spider",
+	packet.SoundEventImitateCreeper:                     "imitate_creeper",
+	packet.SoundEventImitateElderGuardian:               "imitate_elder_guardian",
+	packet.SoundEventImitateEnderDragon:                 "imitate_ender_dragon",
+	packet.SoundEventImitateEnderman:                    "imitate_enderman",
+	packet.SoundEventImitateEndermite:                   "imitate_endermite",
+	packet.SoundEventImitateEvocationIllager:            "imitate_evocation_illager",
+	packet.SoundEventImitateGhast:                       "imitate_ghast",
+	packet.SoundEventImitateHusk:                        "imitate_husk",
+	packet.SoundEventImitateIllusionIllager:             "imitate_illusion_illager",
+	packet.SoundEventImitateMagmaCube:                   "imitate_magma_cube",
+	packet.SoundEventImitatePolarBear:                   "imitate_polar_bear",
+	packet.SoundEventImitateShulker:                     "imitate_shulker",
+	packet.SoundEventImitateSilverfish:                  "imitate_silverfish",
+	packet.SoundEventImitateSkeleton:                    "imitate_skeleton",
+	packet.SoundEventImitateSlime:                       "imitate_slime",
+	packet.SoundEventImitateSpider:                      "imitate_spider",
+	packet.SoundEventImitateStray:                       "imitate_stray",
+	packet.SoundEventImitateVex:                         "imitate_vex",
+	packet.SoundEventImitateVindicationIllager:          "imitate_vindication_illager",
+	packet.SoundEventImitateWitch:                       "imitate_witch",
+	packet.SoundEventImitateWither:                      "imitate_wither",
+	packet.SoundEventImitateWitherSkeleton:              "imitate_wither_skeleton",
+	packet.SoundEventImitateWolf:                        "imitate_wolf",
+	packet.SoundEventImitateZombie:                      "imitate_zombie",
+	packet.SoundEventImitateZombiePigman:                "imitate_zombie_pigman",
+	packet.SoundEventImitateZombieVillager:              "imitate_zombie_villager",
+	packet.SoundEventEnderEyePlaced:                     "ender_eye_placed",
+	packet.SoundEventEndPortalCreated:                   "end_portal_created",
+	packet.SoundEventAnvilUse:                           "anvil_use",
+	packet.SoundEventBottleDragonBreath:                 "bottle_dragon_breath",
+	packet.SoundEventPortalTravel:                       "portal_travel",
+	packet.SoundEventTridentHit:                         "trident_hit",
+	packet.SoundEventTridentReturn:                      "trident_return",
+	packet.SoundEventTridentRiptide1:                    "trident_riptide1",
+	packet.SoundEventTridentRiptide2:                    "trident_riptide2",
+	packet.SoundEventTridentRiptide3:                    "trident_riptide3",
+	packet.SoundEventTridentThrow:                       "trident_throw",
+	packet.SoundEventTridentThunder:                     "trident_thunder",
+	packet.SoundEventTridentHitGround:                   "trident_hit_ground",
+	packet.SoundEventDefault:                            "default",
+	packet.SoundEventFletchingTableUse:                  "fletching_table_use",
+	packet.SoundEventElemConstructOpen:                  "elem_construct_open",
+	packet.SoundEventIceBombHit:                         "ice_bomb_hit",
+	packet.SoundEventBalloonPop:                         "balloon_pop",
+	packet.SoundEventLtReactionIceBomb:                  "lt_reaction_ice_bomb",
+	packet.SoundEventLtReactionBleach:                   "lt_reaction_bleach",
+	packet.SoundEventLtReactionElephantToothpaste:       "lt_reaction_elephant_toothpaste",
+	packet.SoundEventLtReactionElephantToothpaste2:      "lt_reaction_elephant_toothpaste2",
+	packet.SoundEventLtReactionGlowStick:                "lt_reaction_glow_stick",
+	packet.SoundEventLtReactionGlowStick2:               "lt_reaction_glow_stick2",
+	packet.SoundEventLtReactionLuminol:                  "lt_reaction_luminol",
+	packet.SoundEventLtReactionSalt:                     "lt_reaction_salt",
+	packet.SoundEventLtReactionFertilizer:               "lt_reaction_fertilizer",
+	packet.SoundEventLtReactionFireball:                 "lt_reaction_fireball",
+	packet.SoundEventLtReactionMagnesiumSalt:            "lt_reaction_magnesium_salt",
+	packet.SoundEventLtReactionMiscFire:                 "lt_reaction_misc_fire",
+	packet.SoundEventLtReactionFire:                     "lt_reaction_fire",
+	packet.SoundEventLtReactionMiscExplosion:            "lt_reaction_misc_explosion",
+	packet.SoundEventLtReactionMiscMystical:             "lt_reaction_misc_mystical",
+	packet.SoundEventLtReactionMiscMystical2:            "lt_reaction_misc_mystical2",
+	packet.SoundEventLtReactionProduct:                  "lt_reaction_product",
+	packet.SoundEventSparklerUse:                        "sparkler_use",
+	packet.SoundEventGlowStickUse:                       "glow_stick_use",
+	packet.SoundEventSparklerActive:                     "sparkler_active",
+	packet.SoundEventConvertToDrowned:                   "convert_to_drowned",
+	packet.SoundEventBucketFillFish:                     "bucket_fill_fish",
+	packet.SoundEventBucketEmptyFish:                    "bucket_empty_fish",
+	packet.SoundEventBubbleColumnUpwards:                "bubble_column_upwards",
+	packet.SoundEventBubbleColumnDownwards:              "bubble_column_downwards",
+	packet.SoundEventBubblePop:                          "bubble_pop",
+	packet.SoundEventBubbleUpInside:                     "bubble_up_inside",
+	packet.SoundEventBubbleDownInside:                   "bubble_down_inside",
+	packet.SoundEventHurtBaby:                           "hurt_baby",
+	packet.SoundEventDeathBaby:                          "death_baby",
+	packet.SoundEventStepBaby:                           "step_baby",
+	packet.SoundEventSpawnBaby:                          "spawn_baby",
+	packet.SoundEventBorn:                               "born",
+	packet.SoundEventTurtleEggBreak:                     "turtle_egg_break",
+	packet.SoundEventTurtleEggCrack:                     "turtle_egg_crack",
+	packet.SoundEventTurtleEggHatched:                   "turtle_egg_hatched",
+	packet.SoundEventLayEgg:                             "lay_egg",
+	packet.SoundEventTurtleEggAttacked:                  "turtle_egg_attacked",
+	packet.SoundEventBeaconActivate:                     "beacon_activate",
+	packet.SoundEventBeaconAmbient:                      "beacon_ambient",
+	packet.SoundEventBeaconDeactivate:                   "beacon_deactivate",
+	packet.SoundEventBeaconPower:                        "beacon_power",
+	packet.SoundEventConduitActivate:                    "conduit_activate",
+	packet.SoundEventConduitAmbient:                     "conduit_ambient",
+	packet.SoundEventConduitAttack:                      "conduit_attack",
+	packet.SoundEventConduitDeactivate:                  "conduit_deactivate",
+	packet.SoundEventConduitShort:                       "conduit_short",
+	packet.SoundEventSwoop:                              "swoop",
+	packet.SoundEventBambooSaplingPlace:                 "bamboo_sapling_place",
+	packet.SoundEventPreSneeze:                          "pre_sneeze",
+	packet.SoundEventSneeze:                             "sneeze",
+	packet.SoundEventAmbientTame:                        "ambient_tame",
+	packet.SoundEventScared:                             "scared",
+	packet.SoundEventScaffoldingClimb:                   "scaffolding_climb",
+	packet.SoundEventCrossbowLoadingStart:               "crossbow_loading_start",
+	packet.SoundEventCrossbowLoadingMiddle:              "crossbow_loading_middle",
+	packet.SoundEventCrossbowLoadingEnd:                 "crossbow_loading_end",
+	packet.SoundEventCrossbowShoot:                      "crossbow_shoot",
+	packet.SoundEventCrossbowQuickChargeStart:           "crossbow_quick_charge_start",
+	packet.SoundEventCrossbowQuickChargeMiddle:          "crossbow_quick_charge_middle",
+	packet.SoundEventCrossbowQuickChargeEnd:             "crossbow_quick_charge_end",
+	packet.SoundEventAmbientAggressive:                  "ambient_aggressive",
+	packet.SoundEventAmbientWorried:                     "ambient_worried",
+	packet.SoundEventCantBreed:                          "cant_breed",
+	packet.SoundEventShieldBlock:                        "shield_block",
+	packet.SoundEventLecternBookPlace:                   "lectern_book_place",
+	packet.SoundEventGrindstoneUse:                      "grindstone_use",
+	packet.SoundEventBell:                               "bell",
+	packet.SoundEventCampfireCrackle:                    "campfire_crackle",
+	packet.SoundEventRoar:                               "roar",
+	packet.SoundEventStun:                               "stun",
+	packet.SoundEventSweetBerryBushHurt:                 "sweet_berry_bush_hurt",
+	packet.SoundEventSweetBerryBushPick:                 "sweet_berry_bush_pick",
+	packet.SoundEventCartographyTableUse:                "cartography_table_use",
+	packet.SoundEventStonecutterUse:                     "stonecutter_use",
+	packet.SoundEventComposterEmpty:                     "composter_empty",
+	packet.SoundEventComposterFill:                      "composter_fill",
+	packet.SoundEventComposterFillLayer:                 "composter_fill_layer",
+	packet.SoundEventComposterReady:                     "composter_ready",
+	packet.SoundEventBarrelOpen:                         "barrel_open",
+	packet.SoundEventBarrelClose:                        "barrel_close",
+	packet.SoundEventRaidHorn:                           "raid_horn",
+	packet.SoundEventLoomUse:                            "loom_use",
+	packet.SoundEventAmbientInRaid:                      "ambient_in_raid",
+	packet.SoundEventUicartographyTableUse:              "uicartography_table_use",
+	packet.SoundEventUistonecutterUse:                   "uistonecutter_use",
+	packet.SoundEventUiloomUse:                          "uiloom_use",
+	packet.SoundEventSmokerUse:                          "smoker_use",
+	packet.SoundEventBlastFurnaceUse:                    "blast_furnace_use",
+	packet.SoundEventSmithingTableUse:                   "smithing_table_use",
+	packet.SoundEventScreech:                            "screech",
+	packet.SoundEventSleep:                              "sleep",
+	packet.SoundEventFurnaceUse:                         "furnace_use",
+	packet.SoundEventMooshroomConvert:                   "mooshroom_convert",
+	packet.SoundEventMilkSuspiciously:                   "milk_suspiciously",
+	packet.SoundEventCelebrate:                          "celebrate",
+	packet.SoundEventJumpPrevent:                        "jump_prevent",
+	packet.SoundEventAmbientPollinate:                   "ambient_pollinate",
+	packet.SoundEventBeehiveDrip:                        "beehive_drip",
+	packet.SoundEventBeehiveEnter:                       "beehive_enter",
+	packet.SoundEventBeehiveExit:                        "beehive_exit",
+	packet.SoundEventBeehiveWork:                        "beehive_work",
+	packet.SoundEventBeehiveShear:                       "beehive_shear",
+	packet.SoundEventHoneybottleDrink:                   "honeybottle_drink",
+	packet.SoundEventAmbientCave:                        "ambient_cave",
+	packet.SoundEventRetreat:                            "retreat",
+	packet.SoundEventConvertToZombified:                 "convert_to_zombified",
+	packet.SoundEventAdmire:                             "admire",
+	packet.SoundEventStepLava:                           "step_lava",
+	packet.SoundEventTempt:                              "tempt",
+	packet.SoundEventPanic:                              "panic",
+	packet.SoundEventAngry:                              "angry",
+	packet.SoundEventAmbientMoodWarpedForest:            "ambient_mood_warped_forest",
+	packet.SoundEventAmbientMoodSoulsandValley:          "ambient_mood_soulsand_valley",
+	packet.SoundEventAmbientMoodNetherWastes:            "ambient_mood_nether_wastes",
+	packet.SoundEventAmbientMoodBasaltDeltas:            "ambient_mood_basalt_deltas",
+	packet.SoundEventAmbientMoodCrimsonForest:           "ambient_mood_crimson_forest",
+	packet.SoundEventRespawnAnchorCharge:                "respawn_anchor_charge",
+	packet.SoundEventRespawnAnchorDeplete:               "respawn_anchor_deplete",
+	packet.SoundEventRespawnAnchorSetSpawn:              "respawn_anchor_set_spawn",
+	packet.SoundEventRespawnAnchorAmbient:               "respawn_anchor_ambient",
+	packet.SoundEventSoulEscapeQuiet:                    "soul_escape_quiet",
+	packet.SoundEventSoulEscapeLoud:                     "soul_escape_loud",
+	packet.SoundEventRecordPigstep:                      "record_pigstep",
+	packet.SoundEventLinkCompassToLodestone:             "link_compass_to_lodestone",
+	packet.SoundEventUseSmithingTable:                   "use_smithing_table",
+	packet.SoundEventEquipNetherite:                     "equip_netherite",
+	packet.SoundEventAmbientLoopWarpedForest:            "ambient_loop_warped_forest",
+	packet.SoundEventAmbientLoopSoulsandValley:          "ambient_loop_soulsand_valley",
+	packet.SoundEventAmbientLoopNetherWastes:            "ambient_loop_nether_wastes",
+	packet.SoundEventAmbientLoopBasaltDeltas:            "ambient_loop_basalt_deltas",
+	packet.SoundEventAmbientLoopCrimsonForest:           "ambient_loop_crimson_forest",
+	packet.SoundEventAmbientAdditionWarpedForest:        "ambient_addition_warped_forest",
+	packet.SoundEventAmbientAdditionSoulsandValley:      "ambient_addition_soulsand_valley",
+	packet.SoundEventAmbientAdditionNetherWastes:        "ambient_addition_nether_wastes",
+	packet.SoundEventAmbientAdditionBasaltDeltas:        "ambient_addition_basalt_deltas",
+	packet.SoundEventAmbientAdditionCrimsonForest:       "ambient_addition_crimson_forest",
+	packet.SoundEventSculkSensorPowerOn:                 "sculk_sensor_power_on",
+	packet.SoundEventSculkSensorPowerOff:                "sculk_sensor_power_off",
+	packet.SoundEventBucketFillPowderSnow:               "bucket_fill_powder_snow",
+	packet.SoundEventBucketEmptyPowderSnow:              "bucket_empty_powder_snow",
+	packet.SoundEventPointedDripstoneCauldronDripWater:  "pointed_dripstone_cauldron_drip_water",
+	packet.SoundEventPointedDripstoneCauldronDripLava:   "pointed_dripstone_cauldron_drip_lava",
+	packet.SoundEventPointedDripstoneDripWater:          "pointed_dripstone_drip_water",
+	packet.SoundEventPointedDripstoneDripLava:           "pointed_dripstone_drip_lava",
+	packet.SoundEventCaveVinesPickBerries:               "cave_vines_pick_berries",
+	packet.SoundEventBigDripleafTiltDown:                "big_dripleaf_tilt_down",
+	packet.SoundEventBigDripleafTiltUp:                  "big_dripleaf_tilt_up",
+	packet.SoundEventCopperWaxOn:                        "copper_wax_on",
+	packet.SoundEventCopperWaxOff:                       "copper_wax_off",
+	packet.SoundEventScrape:                             "scrape",
+	packet.SoundEventPlayerHurtDrown:                    "player_hurt_drown",
+	packet.SoundEventPlayerHurtOnFire:                   "player_hurt_on_fire",
+	packet.SoundEventPlayerHurtFreeze:                   "player_hurt_freeze",
+	packet.SoundEventUseSpyglass:                        "use_spyglass",
+	packet.SoundEventStopUsingSpyglass:                  "stop_using_spyglass",
+	packet.SoundEventAmethystBlockChime:                 "amethyst_block_chime",
+	packet.SoundEventAmbientScreamer:                    "ambient_screamer",
+	packet.SoundEventHurtScreamer:                       "hurt_screamer",
+	packet.SoundEventDeathScreamer:                      "death_screamer",
+	packet.SoundEventMilkScreamer:                       "milk_screamer",
+	packet.SoundEventJumpToBlock:                        "jump_to_block",
+	packet.SoundEventPreRam:                             "pre_ram",
+	packet.SoundEventPreRamScreamer:                     "pre_ram_screamer",
+	packet.SoundEventRamImpact:                          "ram_impact",
+	packet.SoundEventRamImpactScreamer:                  "ram_impact_screamer",
+	packet.SoundEventSquidInkSquirt:                     "squid_ink_squirt",
+	packet.SoundEventGlowSquidInkSquirt:                 "glow_squid_ink_squirt",
+	packet.SoundEventConvertToStray:                     "convert_to_stray",
+	packet.SoundEventCakeAddCandle:                      "cake_add_candle",
+	packet.SoundEventExtinguishCandle:                   "extinguish_candle",
+	packet.SoundEventAmbientCandle:                      "ambient_candle",
+	packet.SoundEventBlockClick:                         "block_click",
+	packet.SoundEventBlockClickFail:                     "block_click_fail",
+	packet.SoundEventSculkCatalystBloom:                 "sculk_catalyst_bloom",
+	packet.SoundEventSculkShriekerShriek:                "sculk_shrieker_shriek",
+	packet.SoundEventWardenNearbyClose:                  "warden_nearby_close",
+	packet.SoundEventWardenNearbyCloser:                 "warden_nearby_closer",
+	packet.SoundEventWardenNearbyClosest:                "warden_nearby_closest",
+	packet.SoundEventWardenSlightlyAngry:                "warden_slightly_angry",
+	packet.SoundEventRecordOtherside:                    "record_otherside",
+	packet.SoundEventTongue:                             "tongue",
+	packet.SoundEventCrackIronGolem:                     "crack_iron_golem",
+	packet.SoundEventRepairIronGolem:                    "repair_iron_golem",
+	packet.SoundEventListening:                          "listening",
+	packet.SoundEventHeartbeat:                          "heartbeat",
+	packet.SoundEventHornBreak:                          "horn_break",
+	packet.SoundEventSculkSpread:                        "sculk_spread",
+	packet.SoundEventSculkCharge:                        "sculk_charge",
+	packet.SoundEventSculkSensorPlace:                   "sculk_sensor_place",
+	packet.SoundEventSculkShriekerPlace:                 "sculk_shrieker_place",
+	packet.SoundEventGoatCall0:                          "goat_call0",
+	packet.SoundEventGoatCall1:                          "goat_call1",
+	packet.SoundEventGoatCall2:                          "goat_call2",
+	packet.SoundEventGoatCall3:                          "goat_call3",
+	packet.SoundEventGoatCall4:                          "goat_call4",
+	packet.SoundEventGoatCall5:                          "goat_call5",
+	packet.SoundEventGoatCall6:                          "goat_call6",
+	packet.SoundEventGoatCall7:                          "goat_call7",
+	packet.SoundEventImitateWarden:                      "imitate_warden",
+	packet.SoundEventListeningAngry:                     "listening_angry",
+	packet.SoundEventItemGiven:                          "item_given",
+	packet.SoundEventItemTaken:                          "item_taken",
+	packet.SoundEventDisappeared:                        "disappeared",
+	packet.SoundEventReappeared:                         "reappeared",
+	packet.SoundEventDrinkMilk:                          "drink_milk",
+	packet.SoundEventFrogspawnHatched:                   "frogspawn_hatched",
+	packet.SoundEventLaySpawn:                           "lay_spawn",
+	packet.SoundEventFrogspawnBreak:                     "frogspawn_break",
+	packet.SoundEventSonicBoom:                          "sonic_boom",
+	packet.SoundEventSonicCharge:                        "sonic_charge",
+	packet.SoundEventRecord5:                            "record5",
+	packet.SoundEventConvertToFrog:                      "convert_to_frog",
+	packet.SoundEventRecordPlaying:                      "record_playing",
+	packet.SoundEventEnchantingTableUse:                 "enchanting_table_use",
+	packet.SoundEventStepSand:                           "step_sand",
+	packet.SoundEventDashReady:                          "dash_ready",
+	packet.SoundEventBundleDropContents:                 "bundle_drop_contents",
+	packet.SoundEventBundleInsert:                       "bundle_insert",
+	packet.SoundEventBundleRemoveOne:                    "bundle_remove_one",
+	packet.SoundEventPressurePlateClickOff:              "pressure_plate_click_off",
+	packet.SoundEventPressurePlateClickOn:               "pressure_plate_click_on",
+	packet.SoundEventButtonClickOff:                     "button_click_off",
+	packet.SoundEventButtonClickOn:                      "button_click_on",
+	packet.SoundEventDoorOpen:                           "door_open",
+	packet.SoundEventDoorClose:                          "door_close",
+	packet.SoundEventTrapdoorOpen:                       "trapdoor_open",
+	packet.SoundEventTrapdoorClose:                      "trapdoor_close",
+	packet.SoundEventFenceGateOpen:                      "fence_gate_open",
+	packet.SoundEventFenceGateClose:                     "fence_gate_close",
+	packet.SoundEventInsert:                             "insert",
+	packet.SoundEventPickup:                             "pickup",
+	packet.SoundEventInsertEnchanted:                    "insert_enchanted",
+	packet.SoundEventPickupEnchanted:                    "pickup_enchanted",
+	packet.SoundEventBrush:                              "brush",
+	packet.SoundEventBrushCompleted:                     "brush_completed",
+	packet.SoundEventShatterDecoratedPot:                "shatter_decorated_pot",
+	packet.SoundEventBreakDecoratedPot:                  "break_decorated_pot",
+	packet.SoundEventSnifferEggCrack:                    "sniffer_egg_crack",
+	packet.SoundEventSnifferEggHatched:                  "sniffer_egg_hatched",
+	packet.SoundEventWaxedSignInteractFail:              "waxed_sign_interact_fail",
+	packet.SoundEventRecordRelic:                        "record_relic",
+	packet.SoundEventBump:                               "bump",
+	packet.SoundEventPumpkinCarve:                       "pumpkin_carve",
+	packet.SoundEventConvertHuskToZombie:                "convert_husk_to_zombie",
+	packet.SoundEventPigDeath:                           "pig_death",
+	packet.SoundEventHoglinZombified:                    "hoglin_zombified",
+	packet.SoundEventAmbientUnderwaterEnter:             "ambient_underwater_enter",
+	packet.SoundEventAmbientUnderwaterExit:              "ambient_underwater_exit",
+	packet.SoundEventBottleFill:                         "bottle_fill",
+	packet.SoundEventBottleEmpty:                        "bottle_empty",
+	packet.SoundEventCrafterCraft:                       "crafter_craft",
+	packet.SoundEventCrafterFail:                        "crafter_fail",
+	packet.SoundEventDecoratedPotInsert:                 "decorated_pot_insert",
+	packet.SoundEventDecoratedPotInsertFail:             "decorated_pot_insert_fail",
+	packet.SoundEventCrafterDisableSlot:                 "crafter_disable_slot",
+	packet.SoundEventTrialSpawnerOpenShutter:            "trial_spawner_open_shutter",
+	packet.SoundEventTrialSpawnerEjectItem:              "trial_spawner_eject_item",
+	packet.SoundEventTrialSpawnerDetectPlayer:           "trial_spawner_detect_player",
+	packet.SoundEventTrialSpawnerSpawnMob:               "trial_spawner_spawn_mob",
+	packet.SoundEventTrialSpawnerCloseShutter:           "trial_spawner_close_shutter",
+	packet.SoundEventTrialSpawnerAmbient:                "trial_spawner_ambient",
+	packet.SoundEventCopperBulbTurnOn:                   "copper_bulb_turn_on",
+	packet.SoundEventCopperBulbTurnOff:                  "copper_bulb_turn_off",
+	packet.SoundEventAmbientInAir:                       "ambient_in_air",
+	packet.SoundEventBreezeWindChargeBurst:              "breeze_wind_charge_burst",
+	packet.SoundEventImitateBreeze:                      "imitate_breeze",
+	packet.SoundEventArmadilloBrush:                     "armadillo_brush",
+	packet.SoundEventArmadilloScuteDrop:                 "armadillo_scute_drop",
+	packet.SoundEventEquipWolf:                          "equip_wolf",
+	packet.SoundEventUnequipWolf:                        "unequip_wolf",
+	packet.SoundEventReflect:                            "reflect",
+	packet.SoundEventVaultOpenShutter:                   "vault_open_shutter",
+	packet.SoundEventVaultCloseShutter:                  "vault_close_shutter",
+	packet.SoundEventVaultEjectItem:                     "vault_eject_item",
+	packet.SoundEventVaultInsertItem:                    "vault_insert_item",
+	packet.SoundEventVaultInsertItemFail:                "vault_insert_item_fail",
+	packet.SoundEventVaultAmbient:                       "vault_ambient",
+	packet.SoundEventVaultActivate:                      "vault_activate",
+	packet.SoundEventVaultDeactive:                      "vault_deactive",
+	packet.SoundEventHurtReduced:                        "hurt_reduced",
+	packet.SoundEventWindChargeBurst:                    "wind_charge_burst",
+	packet.SoundEventImitateBogged:                      "imitate_bogged",
+	packet.SoundEventWolfArmourCrack:                    "wolf_armour_crack",
+	packet.SoundEventWolfArmourBreak:                    "wolf_armour_break",
+	packet.SoundEventWolfArmourRepair:                   "wolf_armour_repair",
+	packet.SoundEventMaceSmashAir:                       "mace_smash_air",
+	packet.SoundEventMaceSmashGround:                    "mace_smash_ground",
+	packet.SoundEventTrialSpawnerChargeActivate:         "trial_spawner_charge_activate",
+	packet.SoundEventTrialSpawnerAmbientOminous:         "trial_spawner_ambient_ominous",
+	packet.SoundEventOminiousItemSpawnerSpawnItem:       "ominious_item_spawner_spawn_item",
+	packet.SoundEventOminousBottleEndUse:                "ominous_bottle_end_use",
+	packet.SoundEventMaceHeavySmashGround:               "mace_heavy_smash_ground",
+	packet.SoundEventOminousItemSpawnerSpawnItemBegin:   "ominous_item_spawner_spawn_item_begin",
+	packet.SoundEventApplyEffectBadOmen:                 "apply_effect_bad_omen",
+	packet.SoundEventApplyEffectRaidOmen:                "apply_effect_raid_omen",
+	packet.SoundEventApplyEffectTrialOmen:               "apply_effect_trial_omen",
+	packet.SoundEventOminousItemSpawnerAboutToSpawnItem: "ominous_item_spawner_about_to_spawn_item",
+	packet.SoundEventRecordCreator:                      "record_creator",
+	packet.SoundEventRecordCreatorMusicBox:              "record_creator_music_box",
+	packet.SoundEventRecordPrecipice:                    "record_precipice",
+	packet.SoundEventVaultRejectRewardedPlayer:          "vault_reject_rewarded_player",
+	packet.SoundEventImitateDrowned:                     "imitate_drowned",
+	packet.SoundEventImitateCreaking:                    "imitate_creaking",
+	packet.SoundEventBundleInsertFailed:                 "bundle_insert_failed",
+	packet.SoundEventSpongeAbsorb:                       "sponge_absorb",
+	packet.SoundEventBlockCreakingHeartTrail:            "block_creaking_heart_trail",
+	packet.SoundEventCreakingHeartSpawn:                 "creaking_heart_spawn",
+	packet.SoundEventActivate:                           "activate",
+	packet.SoundEventDeactivate:                         "deactivate",
+	packet.SoundEventFreeze:                             "freeze",
+	packet.SoundEventUnfreeze:                           "unfreeze",
+	packet.SoundEventOpen:                               "open",
+	packet.SoundEventOpenLong:                           "open_long",
+	packet.SoundEventClose:                              "close",
+	packet.SoundEventCloseLong:                          "close_long",
+	packet.SoundEventImitatePhantom:                     "imitate_phantom",
+	packet.SoundEventImitateZoglin:                      "imitate_zoglin",
+	packet.SoundEventImitateGuardian:                    "imitate_guardian",
+	packet.SoundEventImitateRavager:                     "imitate_ravager",
+	packet.SoundEventImitatePillager:                    "imitate_pillager",
+	packet.SoundEventPlaceInWater:                       "place_in_water",
+	packet.SoundEventStateChange:                        "state_change",
+	packet.SoundEventImitateHappyGhast:                  "imitate_happy_ghast",
+	packet.SoundEventUniqueGeneric:                      "unique_generic",
+	packet.SoundEventRecordTears:                        "record_tears",
+	packet.SoundEventTheEndLightFlash:                   "the_end_light_flash",
+	packet.SoundEventLeadLeash:                          "lead_leash",
+	packet.SoundEventLeadUnleash:                        "lead_unleash",
+	packet.SoundEventLeadBreak:                          "lead_break",
+	packet.SoundEventUnsaddle:                           "unsaddle",
+	packet.SoundEventEquipCopper:                        "equip_copper",
+	packet.SoundEventRecordLavaChicken:                  "record_lava_chicken",
+	packet.SoundEventPlaceItem:                          "place_item",
+	packet.SoundEventSingleItemSwap:                     "single_item_swap",
+	packet.SoundEventMultiItemSwap:                      "multi_item_swap",
+	packet.SoundEventItemEnchantLunge1:                  "item_enchant_lunge1",
+	packet.SoundEventItemEnchantLunge2:                  "item_enchant_lunge2",
+	packet.SoundEventItemEnchantLunge3:                  "item_enchant_lunge3",
+	packet.SoundEventAttackCritical:                     "attack_critical",
+	packet.SoundEventItemSpearAttackHit:                 "item_spear_attack_hit",
+	packet.SoundEventItemSpearAttackMiss:                "item_spear_attack_miss",
+	packet.SoundEventItemWoodenSpearAttackHit:           "item_wooden_spear_attack_hit",
+	packet.SoundEventItemWoodenSpearAttackMiss:          "item_wooden_spear_attack_miss",
+	packet.SoundEventImitateParched:                     "imitate_parched",
+	packet.SoundEventImitateCamelHusk:                   "imitate_camel_husk",
+	packet.SoundEventItemSpearUse:                       "item_spear_use",
+	packet.SoundEventItemWoodenSpearUse:                 "item_wooden_spear_use",
+	packet.SoundEventSaddleInWater:                      "saddle_in_water",
+	packet.SoundEventItemStoneSpearAttackHit:            "item_stone_spear_attack_hit",
+	packet.SoundEventItemIronSpearAttackHit:             "item_iron_spear_attack_hit",
+	packet.SoundEventItemCopperSpearAttackHit:           "item_copper_spear_attack_hit",
+	packet.SoundEventItemGoldenSpearAttackHit:           "item_golden_spear_attack_hit",
+	packet.SoundEventItemDiamondSpearAttackHit:          "item_diamond_spear_attack_hit",
+	packet.SoundEventItemNetheriteSpearAttackHit:        "item_netherite_spear_attack_hit",
+	packet.SoundEventItemStoneSpearAttackMiss:           "item_stone_spear_attack_miss",
+	packet.SoundEventItemIronSpearAttackMiss:            "item_iron_spear_attack_miss",
+	packet.SoundEventItemCopperSpearAttackMiss:          "item_copper_spear_attack_miss",
+	packet.SoundEventItemGoldenSpearAttackMiss:          "item_golden_spear_attack_miss",
+	packet.SoundEventItemDiamondSpearAttackMiss:         "item_diamond_spear_attack_miss",
+	packet.SoundEventItemNetheriteSpearAttackMiss:       "item_netherite_spear_attack_miss",
+	packet.SoundEventItemStoneSpearUse:                  "item_stone_spear_use",
+	packet.SoundEventItemIronSpearUse:                   "item_iron_spear_use",
+	packet.SoundEventItemCopperSpearUse:                 "item_copper_spear_use",
+	packet.SoundEventItemGoldenSpearUse:                 "item_golden_spear_use",
+	packet.SoundEventItemDiamondSpearUse:                "item_diamond_spear_use",
+	packet.SoundEventItemNetheriteSpearUse:              "item_netherite_spear_use",
+}
+
+// GetSoundEventName returns the name for a LevelSoundEvent sound type
+// Unknown types are returned as "sound_event:<id>"
+func GetSoundEventName(soundType uint32) string {
+	if name, ok := SoundEventToName[soundType]; ok {
+		return name
+	}
+	return fmt.Sprintf("sound_event:%d", soundType)
+}
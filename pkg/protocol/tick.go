@@ -0,0 +1,36 @@
+package protocol
+
+import (
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	"github.com/gollilla/best/pkg/events"
+	"github.com/gollilla/best/pkg/types"
+)
+
+// observeTick emits EventServerTick for packets stamped with a server tick
+// Packets with a zero tick are ignored, since many servers leave the field unset.
+func (c *Client) observeTick(pk packet.Packet) {
+	var tick types.ServerTick
+	switch p := pk.(type) {
+	case *packet.UpdateAttributes:
+		tick = types.ServerTick{Source: types.TickSourceActor, Tick: p.Tick}
+	case *packet.MovePlayer:
+		tick = types.ServerTick{Source: types.TickSourceActor, Tick: p.Tick}
+	case *packet.SetActorData:
+		tick = types.ServerTick{Source: types.TickSourceActor, Tick: p.Tick}
+	case *packet.SetActorMotion:
+		tick = types.ServerTick{Source: types.TickSourceActor, Tick: p.Tick}
+	case *packet.SetTime:
+		if p.Time < 0 {
+			return
+		}
+		tick = types.ServerTick{Source: types.TickSourceTime, Tick: uint64(p.Time)}
+	default:
+		return
+	}
+
+	if tick.Tick == 0 {
+		return
+	}
+	c.emitter.Emit(events.EventServerTick, &tick)
+}
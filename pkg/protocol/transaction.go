@@ -0,0 +1,66 @@
+package protocol
+
+import (
+	"fmt"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+// clickedBlockCenter is the position within the clicked block reported for block interactions
+var clickedBlockCenter = mgl32.Vec3{0.5, 0.5, 0.5}
+
+// BuildUseItemTransaction builds use-item transaction data for a block interaction
+// action is one of protocol.UseItemActionClickBlock, UseItemActionClickAir or UseItemActionBreakBlock.
+// The same data is used in InventoryTransaction packets and as PlayerAuthInput item interaction data.
+// hotBarSlot and heldItem describe the selected hotbar slot, which servers check against their inventory.
+func BuildUseItemTransaction(action uint32, blockPos protocol.BlockPos, face int32, playerPos mgl32.Vec3, hotBarSlot int32, heldItem protocol.ItemInstance) *protocol.UseItemTransactionData {
+	return &protocol.UseItemTransactionData{
+		ActionType:       action,
+		TriggerType:      protocol.TriggerTypePlayerInput,
+		BlockPosition:    blockPos,
+		BlockFace:        face,
+		HotBarSlot:       hotBarSlot,
+		HeldItem:         heldItem,
+		Position:         playerPos,
+		ClickedPosition:  clickedBlockCenter,
+		ClientPrediction: protocol.ClientPredictionSuccess,
+	}
+}
+
+// BuildAttackTransaction builds use-item-on-entity transaction data attacking an entity
+func BuildAttackTransaction(targetRuntimeID uint64, playerPos mgl32.Vec3) *protocol.UseItemOnEntityTransactionData {
+	return &protocol.UseItemOnEntityTransactionData{
+		TargetEntityRuntimeID: targetRuntimeID,
+		ActionType:            protocol.UseItemOnEntityActionAttack,
+		Position:              playerPos,
+	}
+}
+
+// BuildInteractTransaction builds use-item-on-entity transaction data interacting with an entity
+// clickedPos is the clicked position relative to the entity.
+func BuildInteractTransaction(targetRuntimeID uint64, playerPos, clickedPos mgl32.Vec3) *protocol.UseItemOnEntityTransactionData {
+	return &protocol.UseItemOnEntityTransactionData{
+		TargetEntityRuntimeID: targetRuntimeID,
+		ActionType:            protocol.UseItemOnEntityActionInteract,
+		Position:              playerPos,
+		ClickedPosition:       clickedPos,
+	}
+}
+
+// BuildReleaseItemTransaction builds release-item transaction data, e.g. to shoot a drawn bow
+func BuildReleaseItemTransaction(playerPos mgl32.Vec3) *protocol.ReleaseItemTransactionData {
+	return &protocol.ReleaseItemTransactionData{
+		ActionType:   protocol.ReleaseItemActionRelease,
+		HeadPosition: playerPos,
+	}
+}
+
+// WriteTransaction sends transaction data in an InventoryTransaction packet
+func (c *Client) WriteTransaction(data protocol.InventoryTransactionData) error {
+	if data == nil {
+		return fmt.Errorf("missing transaction data")
+	}
+	return c.WritePacket(&packet.InventoryTransaction{TransactionData: data})
+}
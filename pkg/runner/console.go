@@ -2,19 +2,34 @@ package runner
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
 // ConsoleReporter is a simple console-based reporter
 type ConsoleReporter struct {
-	indent string
+	depth         int // nesting level of the named suite being reported
+	slowThreshold time.Duration
 }
 
 // NewConsoleReporter creates a new console reporter
 func NewConsoleReporter() *ConsoleReporter {
-	return &ConsoleReporter{
-		indent: "",
+	return &ConsoleReporter{}
+}
+
+// SetSlowThreshold sets the duration above which tests are flagged as slow
+func (r *ConsoleReporter) SetSlowThreshold(threshold time.Duration) {
+	r.slowThreshold = threshold
+}
+
+// slowSuffix returns a "SLOW" marker for tests exceeding the slow threshold
+func (r *ConsoleReporter) slowSuffix(duration int64) string {
+	d := time.Duration(duration) * time.Millisecond
+	if r.slowThreshold <= 0 || d <= r.slowThreshold {
+		return ""
 	}
+	return fmt.Sprintf(" SLOW: %.1fs", d.Seconds())
 }
 
 func (r *ConsoleReporter) OnStart(suiteCount int) {
@@ -32,13 +47,16 @@ func (r *ConsoleReporter) OnEnd(result *TestResult) {
 	fmt.Printf("  Duration: %dms\n", result.Duration.Milliseconds())
 	fmt.Println(separator)
 
+	r.printSlowTests(result)
+	r.printSkippedTests(result)
+
 	if result.Failed > 0 {
 		fmt.Println("\nFailed Tests:")
-		for _, suite := range result.Suites {
+		result.WalkSuites(func(suiteName string, suite *SuiteResult) {
 			for _, test := range suite.Tests {
 				if test.Status == TestStatusFailed {
-					if suite.Name != "" {
-						fmt.Printf("\n  ✗ %s > %s\n", suite.Name, test.Name)
+					if suiteName != "" {
+						fmt.Printf("\n  ✗ %s > %s\n", suiteName, test.Name)
 					} else {
 						fmt.Printf("\n  ✗ %s\n", test.Name)
 					}
@@ -53,19 +71,85 @@ func (r *ConsoleReporter) OnEnd(result *TestResult) {
 					}
 				}
 			}
+		})
+	}
+}
+
+// printSlowTests lists tests that exceeded the slow threshold, slowest first
+func (r *ConsoleReporter) printSlowTests(result *TestResult) {
+	type slowTest struct {
+		name     string
+		duration time.Duration
+	}
+
+	var slow []slowTest
+	result.WalkSuites(func(suiteName string, suite *SuiteResult) {
+		for _, test := range suite.Tests {
+			if !test.Slow {
+				continue
+			}
+			name := test.Name
+			if suiteName != "" {
+				name = suiteName + " > " + test.Name
+			}
+			slow = append(slow, slowTest{name: name, duration: test.Duration})
 		}
+	})
+	if len(slow) == 0 {
+		return
+	}
+
+	sort.Slice(slow, func(i, j int) bool {
+		return slow[i].duration > slow[j].duration
+	})
+
+	fmt.Printf("\nSlow Tests (> %v):\n", r.slowThreshold)
+	for _, t := range slow {
+		fmt.Printf("  SLOW: %.1fs  %s\n", t.duration.Seconds(), t.name)
 	}
 }
 
+// printSkippedTests lists skipped tests together with their skip reasons
+func (r *ConsoleReporter) printSkippedTests(result *TestResult) {
+	if result.Skipped == 0 {
+		return
+	}
+
+	fmt.Println("\nSkipped Tests:")
+	result.WalkSuites(func(suiteName string, suite *SuiteResult) {
+		for _, test := range suite.Tests {
+			if test.Status != TestStatusSkipped {
+				continue
+			}
+			name := test.Name
+			if suiteName != "" {
+				name = suiteName + " > " + test.Name
+			}
+			if test.SkipReason != "" {
+				fmt.Printf("  ○ %s (%s)\n", name, test.SkipReason)
+			} else {
+				fmt.Printf("  ○ %s\n", name)
+			}
+		}
+	})
+}
+
+// indent returns the indentation for lines of the current suite
+func (r *ConsoleReporter) indent() string {
+	return strings.Repeat("  ", r.depth)
+}
+
 func (r *ConsoleReporter) OnSuiteStart(name string) {
 	if name != "" {
-		fmt.Printf("%s%s\n", r.indent, name)
-		r.indent = "  "
+		fmt.Printf("%s%s\n", r.indent(), name)
+		r.depth++
 	}
 }
 
-func (r *ConsoleReporter) OnSuiteEnd(_ string, _ *SuiteResult) {
-	r.indent = ""
+func (r *ConsoleReporter) OnSuiteEnd(name string, _ *SuiteResult) {
+	if name != "" && r.depth > 0 {
+		r.depth--
+	}
 }
 
 func (r *ConsoleReporter) OnTestStart(_ string) {
@@ -73,20 +157,20 @@ func (r *ConsoleReporter) OnTestStart(_ string) {
 }
 
 func (r *ConsoleReporter) OnTestPass(name string, duration int64) {
-	fmt.Printf("%s  ✓ %s (%dms)\n", r.indent, name, duration)
+	fmt.Printf("%s  ✓ %s (%dms)%s\n", r.indent(), name, duration, r.slowSuffix(duration))
 }
 
 func (r *ConsoleReporter) OnTestFail(name string, err *TestError, duration int64) {
-	fmt.Printf("%s  ✗ %s (%dms)\n", r.indent, name, duration)
-	fmt.Printf("%s    → %s\n", r.indent, err.Message)
+	fmt.Printf("%s  ✗ %s (%dms)%s\n", r.indent(), name, duration, r.slowSuffix(duration))
+	fmt.Printf("%s    → %s\n", r.indent(), err.Message)
 }
 
 func (r *ConsoleReporter) OnTestSkip(name string) {
-	fmt.Printf("%s  ○ %s (skipped)\n", r.indent, name)
+	fmt.Printf("%s  ○ %s (skipped)\n", r.indent(), name)
 }
 
 func (r *ConsoleReporter) OnTestRetry(name string, attempt int) {
-	fmt.Printf("%s  ↻ %s (retry %d)\n", r.indent, name, attempt)
+	fmt.Printf("%s  ↻ %s (retry %d)\n", r.indent(), name, attempt)
 }
 
 // SilentReporter is a reporter that produces no output
@@ -0,0 +1,166 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// JSONSchemaVersion is the version of the JSONReport layout
+// It changes only when fields are removed or change meaning; new fields may be added without a bump.
+const JSONSchemaVersion = 1
+
+// JSONReport is the document written by JSONReporter
+type JSONReport struct {
+	SchemaVersion int         `json:"schemaVersion"`
+	Summary       JSONSummary `json:"summary"`
+	Suites        []JSONSuite `json:"suites"`
+}
+
+// JSONSummary contains the totals of a run
+type JSONSummary struct {
+	Success    bool  `json:"success"` // No test failed
+	Passed     int   `json:"passed"`
+	Failed     int   `json:"failed"`
+	Skipped    int   `json:"skipped"`
+	Total      int   `json:"total"`
+	DurationMs int64 `json:"durationMs"`
+}
+
+// JSONSuite is a suite with its tests and nested suites
+type JSONSuite struct {
+	Name       string      `json:"name"` // Empty for tests defined outside Describe
+	DurationMs int64       `json:"durationMs"`
+	Tests      []JSONTest  `json:"tests"`
+	Suites     []JSONSuite `json:"suites,omitempty"`
+}
+
+// JSONTest is the result of a single test
+type JSONTest struct {
+	Name       string     `json:"name"`
+	Status     TestStatus `json:"status"` // "passed", "failed" or "skipped"
+	DurationMs int64      `json:"durationMs"`
+	Slow       bool       `json:"slow,omitempty"`
+	SkipReason string     `json:"skipReason,omitempty"`
+	Error      *JSONError `json:"error,omitempty"`
+}
+
+// JSONError describes why a test failed
+type JSONError struct {
+	Message  string      `json:"message"`
+	Stack    string      `json:"stack,omitempty"`
+	Expected interface{} `json:"expected,omitempty"`
+	Actual   interface{} `json:"actual,omitempty"`
+}
+
+// NewJSONReport converts a test result into its JSON document
+func NewJSONReport(result *TestResult) *JSONReport {
+	report := &JSONReport{
+		SchemaVersion: JSONSchemaVersion,
+		Summary: JSONSummary{
+			Success:    result.Failed == 0,
+			Passed:     result.Passed,
+			Failed:     result.Failed,
+			Skipped:    result.Skipped,
+			Total:      result.Passed + result.Failed + result.Skipped,
+			DurationMs: result.Duration.Milliseconds(),
+		},
+		Suites: make([]JSONSuite, 0, len(result.Suites)),
+	}
+	for _, suite := range result.Suites {
+		report.Suites = append(report.Suites, newJSONSuite(suite))
+	}
+	return report
+}
+
+func newJSONSuite(suite *SuiteResult) JSONSuite {
+	s := JSONSuite{
+		Name:       suite.Name,
+		DurationMs: suite.Duration.Milliseconds(),
+		Tests:      make([]JSONTest, 0, len(suite.Tests)),
+	}
+	for _, test := range suite.Tests {
+		t := JSONTest{
+			Name:       test.Name,
+			Status:     test.Status,
+			DurationMs: test.Duration.Milliseconds(),
+			Slow:       test.Slow,
+			SkipReason: test.SkipReason,
+		}
+		if test.Error != nil {
+			t.Error = &JSONError{
+				Message:  test.Error.Message,
+				Stack:    test.Error.Stack,
+				Expected: jsonValue(test.Error.Expected),
+				Actual:   jsonValue(test.Error.Actual),
+			}
+		}
+		s.Tests = append(s.Tests, t)
+	}
+	for _, child := range suite.Suites {
+		s.Suites = append(s.Suites, newJSONSuite(child))
+	}
+	return s
+}
+
+// jsonValue returns v if it can be encoded as JSON, or its %v formatting otherwise
+// Assertion values such as channels, functions or NaN would otherwise fail the whole report.
+func jsonValue(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return v
+}
+
+// JSONReporter writes the whole result tree as a JSONReport when the run ends
+// It prints nothing while tests run.
+type JSONReporter struct {
+	w    io.Writer
+	path string
+	err  error
+}
+
+// NewJSONReporter creates a reporter writing the JSON report to w
+func NewJSONReporter(w io.Writer) *JSONReporter {
+	return &JSONReporter{w: w}
+}
+
+// NewJSONFileReporter creates a reporter writing the JSON report to the file at path
+// The file is created or truncated when the run ends.
+func NewJSONFileReporter(path string) *JSONReporter {
+	return &JSONReporter{path: path}
+}
+
+// Err returns the error from writing the report, if any
+func (r *JSONReporter) Err() error {
+	return r.err
+}
+
+func (r *JSONReporter) OnStart(_ int) {}
+
+func (r *JSONReporter) OnEnd(result *TestResult) {
+	data, err := json.MarshalIndent(NewJSONReport(result), "", "  ")
+	if err != nil {
+		r.err = err
+		return
+	}
+	data = append(data, '\n')
+
+	if r.path != "" {
+		r.err = os.WriteFile(r.path, data, 0644)
+		return
+	}
+	_, r.err = r.w.Write(data)
+}
+
+func (r *JSONReporter) OnSuiteStart(_ string)                      {}
+func (r *JSONReporter) OnSuiteEnd(_ string, _ *SuiteResult)        {}
+func (r *JSONReporter) OnTestStart(_ string)                       {}
+func (r *JSONReporter) OnTestPass(_ string, _ int64)               {}
+func (r *JSONReporter) OnTestFail(_ string, _ *TestError, _ int64) {}
+func (r *JSONReporter) OnTestSkip(_ string)                        {}
+func (r *JSONReporter) OnTestRetry(_ string, _ int)                {}
@@ -0,0 +1,44 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestJSONReporterStringifiesUnencodableValues(t *testing.T) {
+	result := &TestResult{
+		Failed: 1,
+		Suites: []*SuiteResult{{
+			Tests: []*TestCaseResult{{
+				Name:   "compares odd values",
+				Status: TestStatusFailed,
+				Error: &TestError{
+					Message:  "mismatch",
+					Expected: math.NaN(),
+					Actual:   map[string]interface{}{"ok": 1},
+				},
+			}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	reporter := NewJSONReporter(&buf)
+	reporter.OnEnd(result)
+	if err := reporter.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+
+	var report JSONReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("invalid JSON report: %v", err)
+	}
+	jsonErr := report.Suites[0].Tests[0].Error
+	if jsonErr.Expected != "NaN" {
+		t.Errorf("Expected = %#v, want \"NaN\"", jsonErr.Expected)
+	}
+	if actual, ok := jsonErr.Actual.(map[string]interface{}); !ok || actual["ok"] != float64(1) {
+		t.Errorf("Actual = %#v, want the encodable map unchanged", jsonErr.Actual)
+	}
+}
@@ -0,0 +1,100 @@
+package runner
+
+import "sync"
+
+// runSuitesParallel runs independent suites on a worker pool bounded by MaxConcurrency
+// Hooks and tests within a suite still run in order. Each suite gets its own copy of the global
+// context so a test changing its timeout does not affect other suites, and its reporter output is
+// buffered and written in one piece when it finishes. Results keep registration order; suites not
+// started before the bail threshold is reached are left out, as in sequential runs.
+func (r *TestRunner) runSuitesParallel(hasOnly bool, globalCtx *TestContext) []*SuiteResult {
+	results := make([]*SuiteResult, len(r.suites))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(r.options.MaxConcurrency, len(r.suites)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if r.shouldStop() {
+					continue
+				}
+
+				buffer := &bufferedReporter{}
+				results[i] = r.runSuite(r.suites[i], nil, hasOnly, globalCtx.forSuite(), buffer)
+
+				r.reportMu.Lock()
+				buffer.flush(r.options.Reporter)
+				r.reportMu.Unlock()
+			}
+		}()
+	}
+
+	for i := range r.suites {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	suiteResults := make([]*SuiteResult, 0, len(results))
+	for _, result := range results {
+		if result != nil {
+			suiteResults = append(suiteResults, result)
+		}
+	}
+	return suiteResults
+}
+
+// bufferedReporter records reporter calls of a single suite for replay on another reporter
+type bufferedReporter struct {
+	calls []func(Reporter)
+}
+
+func (b *bufferedReporter) record(call func(Reporter)) {
+	b.calls = append(b.calls, call)
+}
+
+// flush replays the recorded calls in order
+func (b *bufferedReporter) flush(target Reporter) {
+	for _, call := range b.calls {
+		call(target)
+	}
+	b.calls = nil
+}
+
+func (b *bufferedReporter) OnStart(suiteCount int) {
+	b.record(func(rep Reporter) { rep.OnStart(suiteCount) })
+}
+
+func (b *bufferedReporter) OnEnd(result *TestResult) {
+	b.record(func(rep Reporter) { rep.OnEnd(result) })
+}
+
+func (b *bufferedReporter) OnSuiteStart(name string) {
+	b.record(func(rep Reporter) { rep.OnSuiteStart(name) })
+}
+
+func (b *bufferedReporter) OnSuiteEnd(name string, result *SuiteResult) {
+	b.record(func(rep Reporter) { rep.OnSuiteEnd(name, result) })
+}
+
+func (b *bufferedReporter) OnTestStart(name string) {
+	b.record(func(rep Reporter) { rep.OnTestStart(name) })
+}
+
+func (b *bufferedReporter) OnTestPass(name string, duration int64) {
+	b.record(func(rep Reporter) { rep.OnTestPass(name, duration) })
+}
+
+func (b *bufferedReporter) OnTestFail(name string, err *TestError, duration int64) {
+	b.record(func(rep Reporter) { rep.OnTestFail(name, err, duration) })
+}
+
+func (b *bufferedReporter) OnTestSkip(name string) {
+	b.record(func(rep Reporter) { rep.OnTestSkip(name) })
+}
+
+func (b *bufferedReporter) OnTestRetry(name string, attempt int) {
+	b.record(func(rep Reporter) { rep.OnTestRetry(name, attempt) })
+}
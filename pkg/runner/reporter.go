@@ -1,5 +1,7 @@
 package runner
 
+import "time"
+
 // Reporter is the interface for test result reporting
 type Reporter interface {
 	OnStart(suiteCount int)
@@ -12,3 +14,8 @@ type Reporter interface {
 	OnTestSkip(name string)
 	OnTestRetry(name string, attempt int)
 }
+
+// slowThresholdSetter is implemented by reporters that flag slow tests
+type slowThresholdSetter interface {
+	SetSlowThreshold(threshold time.Duration)
+}
@@ -3,6 +3,8 @@ package runner
 import (
 	"fmt"
 	"runtime/debug"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/gollilla/best/pkg/assertions"
@@ -17,6 +19,9 @@ type TestRunner struct {
 	globalAfterAll   []HookFunction
 	globalBeforeEach []HookFunction
 	globalAfterEach  []HookFunction
+	failures         int
+	failuresMu       sync.Mutex // guards failures while suites run in parallel
+	reportMu         sync.Mutex // serializes reporter output of parallel suites
 }
 
 // NewTestRunner creates a new test runner
@@ -34,9 +39,17 @@ func NewTestRunner(options *TestRunnerOptions) *TestRunner {
 			opts.MaxConcurrency = options.MaxConcurrency
 		}
 		opts.Bail = options.Bail
+		if options.MaxFailures > 0 {
+			opts.MaxFailures = options.MaxFailures
+		}
 		if options.Retries > 0 {
 			opts.Retries = options.Retries
 		}
+		opts.SlowThreshold = options.SlowThreshold
+	}
+
+	if setter, ok := opts.Reporter.(slowThresholdSetter); ok {
+		setter.SetSlowThreshold(opts.SlowThreshold)
 	}
 
 	return &TestRunner{
@@ -50,6 +63,7 @@ func NewTestRunner(options *TestRunnerOptions) *TestRunner {
 }
 
 // Describe defines a test suite
+// Describe calls inside fn define nested suites, whose hooks run inside the enclosing suite's.
 func (r *TestRunner) Describe(name string, fn func()) *TestRunner {
 	suite := &TestSuite{
 		Name:       name,
@@ -60,13 +74,23 @@ func (r *TestRunner) Describe(name string, fn func()) *TestRunner {
 		AfterEach:  make([]HookFunction, 0),
 	}
 
+	r.defineSuite(suite, fn)
+	return r
+}
+
+// defineSuite collects a suite's tests, hooks and nested suites by running fn
+// The suite is nested in the enclosing Describe, or registered at the top level outside one.
+func (r *TestRunner) defineSuite(suite *TestSuite, fn func()) {
 	prevSuite := r.currentSuite
 	r.currentSuite = suite
 	fn()
 	r.currentSuite = prevSuite
 
-	r.suites = append(r.suites, suite)
-	return r
+	if prevSuite != nil {
+		prevSuite.Suites = append(prevSuite.Suites, suite)
+	} else {
+		r.suites = append(r.suites, suite)
+	}
 }
 
 // Test defines a test case
@@ -141,10 +165,16 @@ func (r *TestRunner) AfterEach(fn HookFunction) *TestRunner {
 
 // SkipTest defines a test case that should be skipped
 func (r *TestRunner) SkipTest(name string, fn TestFunction) *TestRunner {
+	return r.SkipTestReason(name, "", fn)
+}
+
+// SkipTestReason defines a test case that should be skipped, recording why
+func (r *TestRunner) SkipTestReason(name, reason string, fn TestFunction) *TestRunner {
 	testCase := &TestCase{
-		Name: name,
-		Fn:   fn,
-		Skip: true,
+		Name:       name,
+		Fn:         fn,
+		Skip:       true,
+		SkipReason: reason,
 	}
 
 	if r.currentSuite != nil {
@@ -155,6 +185,11 @@ func (r *TestRunner) SkipTest(name string, fn TestFunction) *TestRunner {
 
 // SkipDescribe defines a test suite that should be skipped
 func (r *TestRunner) SkipDescribe(name string, fn func()) *TestRunner {
+	return r.SkipDescribeReason(name, "", fn)
+}
+
+// SkipDescribeReason defines a test suite that should be skipped, recording why
+func (r *TestRunner) SkipDescribeReason(name, reason string, fn func()) *TestRunner {
 	suite := &TestSuite{
 		Name:       name,
 		Tests:      make([]*TestCase, 0),
@@ -163,14 +198,10 @@ func (r *TestRunner) SkipDescribe(name string, fn func()) *TestRunner {
 		BeforeEach: make([]HookFunction, 0),
 		AfterEach:  make([]HookFunction, 0),
 		Skip:       true,
+		SkipReason: reason,
 	}
 
-	prevSuite := r.currentSuite
-	r.currentSuite = suite
-	fn()
-	r.currentSuite = prevSuite
-
-	r.suites = append(r.suites, suite)
+	r.defineSuite(suite, fn)
 	return r
 }
 
@@ -200,12 +231,7 @@ func (r *TestRunner) OnlyDescribe(name string, fn func()) *TestRunner {
 		Only:       true,
 	}
 
-	prevSuite := r.currentSuite
-	r.currentSuite = suite
-	fn()
-	r.currentSuite = prevSuite
-
-	r.suites = append(r.suites, suite)
+	r.defineSuite(suite, fn)
 	return r
 }
 
@@ -220,6 +246,7 @@ func (r *TestRunner) Run() (*TestResult, error) {
 	}
 
 	startTime := time.Now()
+	r.failures = 0
 	r.options.Reporter.OnStart(len(r.suites))
 
 	// Check for "only" tests
@@ -228,16 +255,37 @@ func (r *TestRunner) Run() (*TestResult, error) {
 	// Create global context
 	globalCtx := r.createContext()
 
+	// Global afterAll hooks always run, even if beforeAll fails partway,
+	// so resources such as connected agents are not leaked (ignore errors)
+	afterAllDone := false
+	runGlobalAfterAll := func() {
+		if afterAllDone {
+			return
+		}
+		afterAllDone = true
+		_ = r.runHooks(r.globalAfterAll, globalCtx)
+	}
+	defer runGlobalAfterAll()
+
 	// Run global beforeAll hooks
 	if err := r.runHooks(r.globalBeforeAll, globalCtx); err != nil {
 		return nil, fmt.Errorf("global beforeAll hook failed: %w", err)
 	}
 
 	// Run test suites
-	for _, suite := range r.suites {
-		suiteResult := r.runSuite(suite, hasOnly, globalCtx)
-		result.Suites = append(result.Suites, suiteResult)
+	if r.options.Parallel && r.options.MaxConcurrency > 1 {
+		result.Suites = r.runSuitesParallel(hasOnly, globalCtx)
+	} else {
+		for _, suite := range r.suites {
+			result.Suites = append(result.Suites, r.runSuite(suite, nil, hasOnly, globalCtx.forSuite(), r.options.Reporter))
 
+			if r.shouldStop() {
+				break
+			}
+		}
+	}
+
+	result.WalkSuites(func(_ string, suiteResult *SuiteResult) {
 		for _, test := range suiteResult.Tests {
 			switch test.Status {
 			case TestStatusPassed:
@@ -248,14 +296,10 @@ func (r *TestRunner) Run() (*TestResult, error) {
 				result.Skipped++
 			}
 		}
+	})
 
-		if r.options.Bail && result.Failed > 0 {
-			break
-		}
-	}
-
-	// Run global afterAll hooks (ignore errors)
-	_ = r.runHooks(r.globalAfterAll, globalCtx)
+	// Run global afterAll hooks before reporting
+	runGlobalAfterAll()
 
 	result.Duration = time.Since(startTime)
 	r.options.Reporter.OnEnd(result)
@@ -269,21 +313,46 @@ func (r *TestRunner) createContext() *TestContext {
 	}
 }
 
+// shouldStop reports whether the failure count has reached the bail threshold
+// Bail is equivalent to MaxFailures of 1
+func (r *TestRunner) shouldStop() bool {
+	limit := r.options.MaxFailures
+	if r.options.Bail {
+		limit = 1
+	}
+
+	r.failuresMu.Lock()
+	defer r.failuresMu.Unlock()
+	return limit > 0 && r.failures >= limit
+}
+
+// addFailure counts a failed test towards the bail threshold
+func (r *TestRunner) addFailure() {
+	r.failuresMu.Lock()
+	r.failures++
+	r.failuresMu.Unlock()
+}
+
 func (r *TestRunner) hasOnlyTests() bool {
 	for _, suite := range r.suites {
-		if suite.Only {
+		if r.suiteHasOnly(suite) {
 			return true
 		}
-		for _, test := range suite.Tests {
-			if test.Only {
-				return true
-			}
-		}
 	}
 	return false
 }
 
-func (r *TestRunner) runSuite(suite *TestSuite, hasOnly bool, globalCtx *TestContext) *SuiteResult {
+// runSuite runs a suite's tests followed by its nested suites
+// parents are the enclosing suites, outermost first; their hooks wrap the suite's own.
+func (r *TestRunner) runSuite(suite *TestSuite, parents []*TestSuite, hasOnly bool, globalCtx *TestContext, reporter Reporter) *SuiteResult {
+	chain := append(slices.Clone(parents), suite)
+	inOnly := slices.ContainsFunc(chain, func(s *TestSuite) bool { return s.Only })
+
+	// Skip if needed
+	if suite.Skip || (hasOnly && !inOnly && !r.suiteHasOnly(suite)) {
+		return r.skipSuite(suite, suite.SkipReason, reporter)
+	}
+
 	suiteResult := &SuiteResult{
 		Name:     suite.Name,
 		Tests:    make([]*TestCaseResult, 0),
@@ -291,128 +360,198 @@ func (r *TestRunner) runSuite(suite *TestSuite, hasOnly bool, globalCtx *TestCon
 	}
 
 	startTime := time.Now()
-	r.options.Reporter.OnSuiteStart(suite.Name)
-
-	// Skip if needed
-	if suite.Skip || (hasOnly && !suite.Only && !r.hasSuiteOnlyTest(suite)) {
-		for _, test := range suite.Tests {
-			suiteResult.Tests = append(suiteResult.Tests, &TestCaseResult{
-				Name:     test.Name,
-				Status:   TestStatusSkipped,
-				Duration: 0,
-			})
-			r.options.Reporter.OnTestSkip(test.Name)
-		}
-		suiteResult.Duration = time.Since(startTime)
-		r.options.Reporter.OnSuiteEnd(suite.Name, suiteResult)
-		return suiteResult
-	}
+	reporter.OnSuiteStart(suite.Name)
 
 	// Run beforeAll hooks
 	if err := r.runHooks(suite.BeforeAll, globalCtx); err != nil {
-		// If beforeAll fails, mark all tests as failed
-		testErr := r.toTestError(err)
-		for _, test := range suite.Tests {
-			suiteResult.Tests = append(suiteResult.Tests, &TestCaseResult{
-				Name:     test.Name,
-				Status:   TestStatusFailed,
-				Duration: 0,
-				Error:    testErr,
-			})
-		}
+		// If beforeAll fails, mark all tests (including nested suites) as failed
+		r.failSuite(suite, suiteResult, r.toTestError(err))
 		suiteResult.Duration = time.Since(startTime)
+		reporter.OnSuiteEnd(suite.Name, suiteResult)
 		return suiteResult
 	}
 
 	// Run tests
 	for _, test := range suite.Tests {
-		testResult := r.runTest(test, suite, hasOnly, globalCtx)
+		testResult := r.runTest(test, chain, inOnly, hasOnly, globalCtx, reporter)
 		suiteResult.Tests = append(suiteResult.Tests, testResult)
 
-		if r.options.Bail && testResult.Status == TestStatusFailed {
+		if testResult.Status == TestStatusFailed {
+			r.addFailure()
+			if r.shouldStop() {
+				break
+			}
+		}
+	}
+
+	// Run nested suites
+	for _, child := range suite.Suites {
+		if r.shouldStop() {
 			break
 		}
+		suiteResult.Suites = append(suiteResult.Suites, r.runSuite(child, chain, hasOnly, globalCtx, reporter))
 	}
 
 	// Run afterAll hooks (ignore errors)
 	_ = r.runHooks(suite.AfterAll, globalCtx)
 
 	suiteResult.Duration = time.Since(startTime)
-	r.options.Reporter.OnSuiteEnd(suite.Name, suiteResult)
+	reporter.OnSuiteEnd(suite.Name, suiteResult)
 	return suiteResult
 }
 
-func (r *TestRunner) hasSuiteOnlyTest(suite *TestSuite) bool {
+// skipSuite reports every test of a suite and its nested suites as skipped
+// reason is used for tests and nested suites that give no reason of their own.
+func (r *TestRunner) skipSuite(suite *TestSuite, reason string, reporter Reporter) *SuiteResult {
+	suiteResult := &SuiteResult{
+		Name:     suite.Name,
+		Tests:    make([]*TestCaseResult, 0),
+		Duration: 0,
+	}
+
+	reporter.OnSuiteStart(suite.Name)
+	for _, test := range suite.Tests {
+		testReason := test.SkipReason
+		if testReason == "" {
+			testReason = reason
+		}
+		suiteResult.Tests = append(suiteResult.Tests, &TestCaseResult{
+			Name:       test.Name,
+			Status:     TestStatusSkipped,
+			Duration:   0,
+			SkipReason: testReason,
+		})
+		reporter.OnTestSkip(test.Name)
+	}
+	for _, child := range suite.Suites {
+		childReason := child.SkipReason
+		if childReason == "" {
+			childReason = reason
+		}
+		suiteResult.Suites = append(suiteResult.Suites, r.skipSuite(child, childReason, reporter))
+	}
+	reporter.OnSuiteEnd(suite.Name, suiteResult)
+	return suiteResult
+}
+
+// failSuite records every test of a suite and its nested suites as failed with testErr
+func (r *TestRunner) failSuite(suite *TestSuite, suiteResult *SuiteResult, testErr *TestError) {
+	for _, test := range suite.Tests {
+		suiteResult.Tests = append(suiteResult.Tests, &TestCaseResult{
+			Name:     test.Name,
+			Status:   TestStatusFailed,
+			Duration: 0,
+			Error:    testErr,
+		})
+		r.addFailure()
+	}
+	for _, child := range suite.Suites {
+		childResult := &SuiteResult{
+			Name:  child.Name,
+			Tests: make([]*TestCaseResult, 0),
+		}
+		r.failSuite(child, childResult, testErr)
+		suiteResult.Suites = append(suiteResult.Suites, childResult)
+	}
+}
+
+// suiteHasOnly reports whether a suite, one of its tests or a nested suite is marked "only"
+func (r *TestRunner) suiteHasOnly(suite *TestSuite) bool {
+	if suite.Only {
+		return true
+	}
 	for _, test := range suite.Tests {
 		if test.Only {
 			return true
 		}
 	}
+	for _, child := range suite.Suites {
+		if r.suiteHasOnly(child) {
+			return true
+		}
+	}
 	return false
 }
 
-func (r *TestRunner) runTest(test *TestCase, suite *TestSuite, hasOnly bool, ctx *TestContext) *TestCaseResult {
+// runTest runs a test of the innermost suite in chain
+// inOnly reports whether the suite or one of its parents is marked "only".
+func (r *TestRunner) runTest(test *TestCase, chain []*TestSuite, inOnly, hasOnly bool, ctx *TestContext, reporter Reporter) *TestCaseResult {
 	// Skip logic
-	if test.Skip || (hasOnly && !test.Only && !suite.Only) {
-		r.options.Reporter.OnTestSkip(test.Name)
+	if test.Skip || (hasOnly && !test.Only && !inOnly) {
+		reporter.OnTestSkip(test.Name)
 		return &TestCaseResult{
-			Name:     test.Name,
-			Status:   TestStatusSkipped,
-			Duration: 0,
+			Name:       test.Name,
+			Status:     TestStatusSkipped,
+			Duration:   0,
+			SkipReason: test.SkipReason,
 		}
 	}
 
-	r.options.Reporter.OnTestStart(test.Name)
+	reporter.OnTestStart(test.Name)
 	startTime := time.Now()
 
 	var lastError interface{}
 	maxAttempts := r.options.Retries + 1
 
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		err := r.executeTest(test, suite, ctx)
+		err := r.executeTest(test, chain, ctx)
 
 		if err == nil {
 			duration := time.Since(startTime)
-			r.options.Reporter.OnTestPass(test.Name, duration.Milliseconds())
+			reporter.OnTestPass(test.Name, duration.Milliseconds())
 			return &TestCaseResult{
 				Name:     test.Name,
 				Status:   TestStatusPassed,
 				Duration: duration,
+				Slow:     r.isSlow(duration),
 			}
 		}
 
 		lastError = err
 
 		if attempt < maxAttempts {
-			r.options.Reporter.OnTestRetry(test.Name, attempt)
+			reporter.OnTestRetry(test.Name, attempt)
 		}
 	}
 
 	duration := time.Since(startTime)
 	testErr := r.toTestError(lastError)
-	r.options.Reporter.OnTestFail(test.Name, testErr, duration.Milliseconds())
+	reporter.OnTestFail(test.Name, testErr, duration.Milliseconds())
 	return &TestCaseResult{
 		Name:     test.Name,
 		Status:   TestStatusFailed,
 		Duration: duration,
 		Error:    testErr,
+		Slow:     r.isSlow(duration),
 	}
 }
 
-func (r *TestRunner) executeTest(test *TestCase, suite *TestSuite, ctx *TestContext) (err error) {
+// isSlow reports whether duration exceeds the configured slow threshold
+func (r *TestRunner) isSlow(duration time.Duration) bool {
+	return r.options.SlowThreshold > 0 && duration > r.options.SlowThreshold
+}
+
+func (r *TestRunner) executeTest(test *TestCase, chain []*TestSuite, suiteCtx *TestContext) (err error) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
 			err = fmt.Errorf("%v", recovered)
 		}
 	}()
 
-	// Run beforeEach hooks
-	allBeforeEach := append(r.globalBeforeEach, suite.BeforeEach...)
+	// Hooks and the test share a per-test context, so SetTimeout only affects this test
+	ctx := suiteCtx.forTest()
+
+	// Run beforeEach hooks, outermost first
+	allBeforeEach := slices.Clone(r.globalBeforeEach)
+	for _, suite := range chain {
+		allBeforeEach = append(allBeforeEach, suite.BeforeEach...)
+	}
 	if err := r.runHooks(allBeforeEach, ctx); err != nil {
 		return err
 	}
 
 	// Run test with timeout
+	startTime := time.Now()
 	done := make(chan struct{})
 	var testErr error
 
@@ -436,17 +575,31 @@ func (r *TestRunner) executeTest(test *TestCase, suite *TestSuite, ctx *TestCont
 		test.Fn(ctx)
 	}()
 
-	select {
-	case <-done:
-		if testErr != nil {
-			return testErr
+	// The deadline moves when the test calls SetTimeout, counting from the start of the test
+	timer := time.NewTimer(ctx.GetTimeout())
+	defer timer.Stop()
+
+wait:
+	for {
+		select {
+		case <-done:
+			if testErr != nil {
+				return testErr
+			}
+			break wait
+		case <-ctx.timeoutChanged:
+			timer.Reset(max(ctx.GetTimeout()-time.Since(startTime), 0))
+		case <-timer.C:
+			return fmt.Errorf("test timeout after %v", ctx.GetTimeout())
 		}
-	case <-time.After(ctx.timeout):
-		return fmt.Errorf("test timeout after %v", ctx.timeout)
 	}
 
-	// Run afterEach hooks (ignore errors in afterEach)
-	allAfterEach := append(suite.AfterEach, r.globalAfterEach...)
+	// Run afterEach hooks, innermost first (ignore errors in afterEach)
+	var allAfterEach []HookFunction
+	for i := len(chain) - 1; i >= 0; i-- {
+		allAfterEach = append(allAfterEach, chain[i].AfterEach...)
+	}
+	allAfterEach = append(allAfterEach, r.globalAfterEach...)
 	_ = r.runHooks(allAfterEach, ctx)
 
 	return nil
@@ -1,6 +1,7 @@
 package runner
 
 import (
+	"sync"
 	"time"
 )
 
@@ -21,20 +22,59 @@ type ServerInfo struct {
 }
 
 // TestContext is passed to test functions
+// Each test run gets its own copy, so a timeout set by one test does not leak into the next.
 type TestContext struct {
-	timeout time.Duration
+	mu             sync.Mutex
+	timeout        time.Duration
+	timeoutChanged chan struct{} // notifies the runner of SetTimeout during a test (nil outside tests)
+}
+
+// SetTimeout overrides the timeout of the current test
+// The timeout counts from the start of the test, so calling SetTimeout(60*time.Second) at the top of
+// a slow test gives it 60 seconds in total. In BeforeEach hooks it applies to the upcoming test; in
+// BeforeAll hooks it changes the default for the following tests.
+func (c *TestContext) SetTimeout(timeout time.Duration) {
+	c.mu.Lock()
+	c.timeout = timeout
+	changed := c.timeoutChanged
+	c.mu.Unlock()
+
+	if changed != nil {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
 }
 
 // Timeout sets the timeout for the current test
+//
+// Deprecated: use SetTimeout.
 func (c *TestContext) Timeout(timeout time.Duration) {
-	c.timeout = timeout
+	c.SetTimeout(timeout)
 }
 
 // GetTimeout returns the current timeout setting
 func (c *TestContext) GetTimeout() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.timeout
 }
 
+// forSuite returns a copy of the context for running a top-level suite
+// A timeout set in the suite's BeforeAll hooks then stays within the suite.
+func (c *TestContext) forSuite() *TestContext {
+	return &TestContext{timeout: c.GetTimeout()}
+}
+
+// forTest returns a copy of the context for running a single test
+func (c *TestContext) forTest() *TestContext {
+	return &TestContext{
+		timeout:        c.GetTimeout(),
+		timeoutChanged: make(chan struct{}, 1),
+	}
+}
+
 // TestFunction is the signature for test functions
 type TestFunction func(ctx *TestContext)
 
@@ -43,21 +83,24 @@ type HookFunction func(ctx *TestContext)
 
 // TestCase represents a single test
 type TestCase struct {
-	Name string
-	Fn   TestFunction
-	Skip bool
-	Only bool
+	Name       string
+	Fn         TestFunction
+	Skip       bool
+	SkipReason string
+	Only       bool
 }
 
 // TestSuite represents a collection of tests
 type TestSuite struct {
 	Name       string
 	Tests      []*TestCase
+	Suites     []*TestSuite // Nested suites, run after Tests
 	BeforeAll  []HookFunction
 	AfterAll   []HookFunction
 	BeforeEach []HookFunction
 	AfterEach  []HookFunction
 	Skip       bool
+	SkipReason string
 	Only       bool
 }
 
@@ -71,16 +114,19 @@ type TestError struct {
 
 // TestCaseResult represents the result of a single test
 type TestCaseResult struct {
-	Name     string
-	Status   TestStatus
-	Duration time.Duration
-	Error    *TestError
+	Name       string
+	Status     TestStatus
+	Duration   time.Duration
+	Error      *TestError
+	Slow       bool   // Duration exceeded TestRunnerOptions.SlowThreshold
+	SkipReason string // Why the test was skipped, if a reason was given
 }
 
 // SuiteResult represents the result of a test suite
 type SuiteResult struct {
 	Name     string
 	Tests    []*TestCaseResult
+	Suites   []*SuiteResult // Results of nested suites
 	Duration time.Duration
 }
 
@@ -93,13 +139,34 @@ type TestResult struct {
 	Suites   []*SuiteResult
 }
 
+// WalkSuites calls fn for every suite result depth-first, including nested suites
+// name is the suite's path from the top level joined with " > " (e.g. "Outer > Inner").
+func (r *TestResult) WalkSuites(fn func(name string, suite *SuiteResult)) {
+	for _, suite := range r.Suites {
+		walkSuite(suite.Name, suite, fn)
+	}
+}
+
+func walkSuite(name string, suite *SuiteResult, fn func(name string, suite *SuiteResult)) {
+	fn(name, suite)
+	for _, child := range suite.Suites {
+		childName := child.Name
+		if name != "" {
+			childName = name + " > " + child.Name
+		}
+		walkSuite(childName, child, fn)
+	}
+}
+
 // TestRunnerOptions configures the test runner
 type TestRunnerOptions struct {
 	Timeout        time.Duration
-	Parallel       bool
-	MaxConcurrency int
+	Parallel       bool // Run suites concurrently; tests within a suite stay sequential
+	MaxConcurrency int  // Maximum number of suites running at once when Parallel is set
 	Reporter       Reporter
 	Bail           bool
+	MaxFailures    int           // Stop after this many failed tests (0 = no limit)
+	SlowThreshold  time.Duration // Flag tests slower than this as slow (0 = disabled)
 	Retries        int
 }
 
@@ -111,6 +178,7 @@ func DefaultOptions() TestRunnerOptions {
 		MaxConcurrency: 4,
 		Reporter:       NewConsoleReporter(),
 		Bail:           false,
+		MaxFailures:    0,
 		Retries:        0,
 	}
 }
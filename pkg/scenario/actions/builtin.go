@@ -3,10 +3,13 @@ package actions
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gollilla/best/pkg/agent"
+	"github.com/gollilla/best/pkg/assertions"
+	"github.com/gollilla/best/pkg/state"
 	"github.com/gollilla/best/pkg/types"
 )
 
@@ -20,6 +23,31 @@ func registerBuiltinActions(r *Registry) {
 		return a.Connect()
 	})
 
+	// connect_agent - Connect an additional named agent
+	r.RegisterAction("connect_agent", ActionDefinition{
+		Description: "追加のエージェントを接続する（後続ステップで agent パラメータに name を指定して操作）",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "エージェント名（後続ステップの agent パラメータで指定）"},
+			{Name: "username", Type: "string", Required: true, Description: "接続するプレイヤー名"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, _ := params["name"].(string)
+		username, _ := params["username"].(string)
+		if _, exists := r.GetAgent(name); exists {
+			return fmt.Errorf("エージェント '%s' は既に接続されています", name)
+		}
+
+		peer := a.Clone(username)
+		if err := peer.Connect(); err != nil {
+			return err
+		}
+		if err := r.AddAgent(name, peer); err != nil {
+			_ = peer.Disconnect()
+			return err
+		}
+		return nil
+	})
+
 	// disconnect - Disconnect from server
 	r.RegisterAction("disconnect", ActionDefinition{
 		Description: "サーバーから切断する",
@@ -28,6 +56,28 @@ func registerBuiltinActions(r *Registry) {
 		return a.Disconnect()
 	})
 
+	// reconnect - Disconnect and connect again
+	r.RegisterAction("reconnect", ActionDefinition{
+		Description: "切断して再接続する（セッションをまたいで状態が保持されるかの確認用）。失敗時は待機時間を倍にしながら再試行",
+		Parameters: []ParameterDef{
+			{Name: "attempts", Type: "number", Required: false, Description: "接続の試行回数", Default: "3"},
+			{Name: "backoff", Type: "duration", Required: false, Description: "最初の再試行までの待機時間", Default: "1s"},
+			{Name: "max_backoff", Type: "duration", Required: false, Description: "再試行間の待機時間の上限", Default: "10s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		var opts agent.ReconnectOptions
+		if attempts, ok := getInt(params, "attempts"); ok {
+			opts.MaxAttempts = attempts
+		}
+		if backoff, ok := getDuration(params, "backoff"); ok {
+			opts.InitialBackoff = backoff
+		}
+		if maxBackoff, ok := getDuration(params, "max_backoff"); ok {
+			opts.MaxBackoff = maxBackoff
+		}
+		return a.ReconnectContext(ctx, opts)
+	})
+
 	// command - Execute a command
 	r.RegisterAction("command", ActionDefinition{
 		Description: "コマンドを実行する",
@@ -39,7 +89,23 @@ func registerBuiltinActions(r *Registry) {
 		if !ok {
 			return fmt.Errorf("cmd parameter is required and must be a string")
 		}
-		return a.Command(cmd)
+		return a.CommandContext(ctx, cmd)
+	})
+
+	// run_macro - Run a command macro from the config
+	r.RegisterAction("run_macro", ActionDefinition{
+		Description: "設定ファイルで定義したコマンドマクロを実行する",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "マクロ名"},
+			{Name: "args", Type: "array", Required: false, Description: "$1, $2, ... に代入する引数"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, ok := params["name"].(string)
+		if !ok {
+			return fmt.Errorf("name parameter is required and must be a string")
+		}
+		args, _ := getStringList(params, "args")
+		return a.RunMacro(name, args...)
 	})
 
 	// chat - Send a chat message
@@ -63,13 +129,9 @@ func registerBuiltinActions(r *Registry) {
 			{Name: "duration", Type: "duration", Required: true, Description: "待機時間（例: 2s, 500ms, 1m）"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
-		durationStr, ok := params["duration"].(string)
+		d, ok := getDuration(params, "duration")
 		if !ok {
-			return fmt.Errorf("duration parameter is required and must be a string")
-		}
-		d, err := time.ParseDuration(durationStr)
-		if err != nil {
-			return fmt.Errorf("invalid duration format: %v", err)
+			return fmt.Errorf("duration parameter is required and must be a duration (e.g. 2s)")
 		}
 		select {
 		case <-time.After(d):
@@ -79,6 +141,30 @@ func registerBuiltinActions(r *Registry) {
 		}
 	})
 
+	// wait_ticks - Wait for a number of server ticks
+	r.RegisterAction("wait_ticks", ActionDefinition{
+		Description: "サーバーのゲームティックが指定数進むまで待機する（20ティック = 約1秒、サーバーの負荷に追従）",
+		Parameters: []ParameterDef{
+			{Name: "ticks", Type: "number", Required: true, Description: "待機するティック数"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 30s）", Default: "30s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		ticks, ok := getInt(params, "ticks")
+		if !ok {
+			return fmt.Errorf("ticks parameter is required and must be a number")
+		}
+
+		timeoutDuration := 30 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+		if deadline, ok := ctx.Deadline(); ok {
+			timeoutDuration = min(timeoutDuration, time.Until(deadline))
+		}
+
+		return a.WaitTicks(ticks, timeoutDuration)
+	})
+
 	// goto - Teleport to a position
 	r.RegisterAction("goto", ActionDefinition{
 		Description: "指定座標にテレポートする",
@@ -86,12 +172,32 @@ func registerBuiltinActions(r *Registry) {
 			{Name: "x", Type: "number", Required: true, Description: "X座標"},
 			{Name: "y", Type: "number", Required: true, Description: "Y座標"},
 			{Name: "z", Type: "number", Required: true, Description: "Z座標"},
+			{Name: "dimension", Type: "string", Required: false, Description: "移動先のディメンション（overworld, nether, the_end）。省略時は現在のディメンション"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
 		x, _ := getFloat(params, "x")
 		y, _ := getFloat(params, "y")
 		z, _ := getFloat(params, "z")
-		return a.Goto(types.Position{X: x, Y: y, Z: z})
+		dimension, _ := params["dimension"].(string)
+		return a.Goto(types.Position{X: x, Y: y, Z: z}, dimension)
+	})
+
+	// record_position - Store the current position under a name
+	r.RegisterAction("record_position", ActionDefinition{
+		Description: "現在位置を名前を付けて記録する（${名前.x}などで参照可能）",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "記録する位置の名前（例: spawn, shop）"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, _ := params["name"].(string)
+		pos := a.Position()
+		r.SetNamedPosition(name, pos)
+		r.SetVariable(name+".x", strconv.FormatFloat(pos.X, 'f', -1, 64))
+		r.SetVariable(name+".y", strconv.FormatFloat(pos.Y, 'f', -1, 64))
+		r.SetVariable(name+".z", strconv.FormatFloat(pos.Z, 'f', -1, 64))
+
+		fmt.Printf("        [record_position] %s: (%.2f, %.2f, %.2f)\n", name, pos.X, pos.Y, pos.Z)
+		return nil
 	})
 
 	// move_relative - Move relative to current position
@@ -110,16 +216,11 @@ func registerBuiltinActions(r *Registry) {
 		dx, _ := getFloat(params, "dx")
 		dy, _ := getFloat(params, "dy")
 		dz, _ := getFloat(params, "dz")
-		newPos := types.Position{
-			X: current.X + dx,
-			Y: current.Y + dy,
-			Z: current.Z + dz,
-		}
 
-		fmt.Printf("        [move_relative] 移動前: (%.2f, %.2f, %.2f) → 移動後: (%.2f, %.2f, %.2f)\n",
-			current.X, current.Y, current.Z, newPos.X, newPos.Y, newPos.Z)
+		fmt.Printf("        [move_relative] 移動前: (%.2f, %.2f, %.2f) → 移動量: (%.2f, %.2f, %.2f)\n",
+			current.X, current.Y, current.Z, dx, dy, dz)
 
-		return a.Goto(newPos)
+		return a.MoveRelative(dx, dy, dz)
 	})
 
 	// look_at - Look at a position
@@ -137,22 +238,126 @@ func registerBuiltinActions(r *Registry) {
 		return a.LookAt(types.Position{X: x, Y: y, Z: z})
 	})
 
+	// turn - Rotate relative to the current rotation
+	r.RegisterAction("turn", ActionDefinition{
+		Description: "現在の向きから相対的に視点を回転する",
+		Parameters: []ParameterDef{
+			{Name: "yaw", Type: "number", Required: false, Description: "水平方向の回転量（度、正で右回り）", Default: "0"},
+			{Name: "pitch", Type: "number", Required: false, Description: "垂直方向の回転量（度、正で下向き）", Default: "0"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		yaw, _ := getFloat(params, "yaw")
+		pitch, _ := getFloat(params, "pitch")
+		return a.Turn(float32(yaw), float32(pitch))
+	})
+
 	// wait_for_spawn - Wait for player to spawn
 	r.RegisterAction("wait_for_spawn", ActionDefinition{
 		Description: "プレイヤーのスポーン完了まで待機する",
 		Parameters: []ParameterDef{
-			{Name: "timeout", Type: "number", Required: false, Description: "タイムアウト秒数", Default: "30"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 30s）", Default: "30s"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
-		timeout := 30.0
-		if t, ok := getFloat(params, "timeout"); ok {
+		timeout := 30 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
 			timeout = t
 		}
-		timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return a.WaitForSpawn(timeoutCtx)
 	})
 
+	// respawn - Respawn after dying
+	r.RegisterAction("respawn", ActionDefinition{
+		Description: "死亡後にリスポーンする",
+		Parameters:  []ParameterDef{},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		return a.Respawn()
+	})
+
+	// select_slot - Select a hotbar slot
+	r.RegisterAction("select_slot", ActionDefinition{
+		Description: "ホットバーのスロットを選択する",
+		Parameters: []ParameterDef{
+			{Name: "slot", Type: "number", Required: true, Description: "スロット番号（0〜8）"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		slot, ok := getInt(params, "slot")
+		if !ok {
+			return fmt.Errorf("slot parameter is required")
+		}
+		return a.SelectSlot(int32(slot))
+	})
+
+	// attack_entity - Attack the nearest entity of a type
+	r.RegisterAction("attack_entity", ActionDefinition{
+		Description: "最も近い指定タイプのエンティティを攻撃する",
+		Parameters: []ParameterDef{
+			{Name: "type", Type: "string", Required: false, Description: "エンティティタイプ（例: minecraft:zombie、省略時はすべて）"},
+			{Name: "distance", Type: "number", Required: false, Description: "対象にする最大距離", Default: "6"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		target, err := findNearestEntity(a, params)
+		if err != nil {
+			return err
+		}
+		return a.AttackEntity(target.RuntimeID)
+	})
+
+	// interact_entity - Interact with the nearest entity of a type
+	r.RegisterAction("interact_entity", ActionDefinition{
+		Description: "最も近い指定タイプのエンティティに右クリック（インタラクト）する",
+		Parameters: []ParameterDef{
+			{Name: "type", Type: "string", Required: false, Description: "エンティティタイプ（例: minecraft:villager、省略時はすべて）"},
+			{Name: "distance", Type: "number", Required: false, Description: "対象にする最大距離", Default: "6"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		target, err := findNearestEntity(a, params)
+		if err != nil {
+			return err
+		}
+		return a.InteractEntity(target.RuntimeID)
+	})
+
+	// break_block - Break a block
+	r.RegisterAction("break_block", ActionDefinition{
+		Description: "指定座標のブロックを破壊する",
+		Parameters: []ParameterDef{
+			{Name: "x", Type: "number", Required: true, Description: "X座標"},
+			{Name: "y", Type: "number", Required: true, Description: "Y座標"},
+			{Name: "z", Type: "number", Required: true, Description: "Z座標"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		x, _ := getFloat(params, "x")
+		y, _ := getFloat(params, "y")
+		z, _ := getFloat(params, "z")
+		return a.BreakBlock(types.Position{X: x, Y: y, Z: z})
+	})
+
+	// place_block - Place the held block
+	r.RegisterAction("place_block", ActionDefinition{
+		Description: "手に持ったブロックを指定座標に設置する（隣接ブロックの face 面をクリック）",
+		Parameters: []ParameterDef{
+			{Name: "x", Type: "number", Required: true, Description: "X座標"},
+			{Name: "y", Type: "number", Required: true, Description: "Y座標"},
+			{Name: "z", Type: "number", Required: true, Description: "Z座標"},
+			{Name: "face", Type: "string", Required: false, Description: "クリックする面（down, up, north, south, west, east または 0〜5）", Default: "up"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		x, _ := getFloat(params, "x")
+		y, _ := getFloat(params, "y")
+		z, _ := getFloat(params, "z")
+
+		face := types.BlockFaceUp
+		if _, ok := params["face"]; ok {
+			var valid bool
+			if face, valid = getBlockFace(params, "face"); !valid {
+				return fmt.Errorf("invalid face %v (expected down, up, north, south, west, east or 0-5)", params["face"])
+			}
+		}
+		return a.PlaceBlock(types.Position{X: x, Y: y, Z: z}, face)
+	})
+
 	// submit_form - Submit a form response
 	r.RegisterAction("submit_form", ActionDefinition{
 		Description: "フォームに回答を送信する",
@@ -160,6 +365,7 @@ func registerBuiltinActions(r *Registry) {
 			{Name: "button_index", Type: "number", Required: false, Description: "選択するボタンのインデックス（0から）"},
 			{Name: "button_text", Type: "string", Required: false, Description: "選択するボタンのテキスト"},
 			{Name: "modal_response", Type: "boolean", Required: false, Description: "ModalFormの場合: true=Button1, false=Button2"},
+			{Name: "values", Type: "array", Required: false, Description: "CustomFormの場合: 要素ごとの値（入力は文字列、トグルは真偽値、スライダーは数値、ドロップダウンは番号か選択肢の文字列。ラベルは省略可）。省略時は各要素のデフォルト値"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
 		form := a.GetLastForm()
@@ -172,9 +378,9 @@ func registerBuiltinActions(r *Registry) {
 		switch f := form.(type) {
 		case *types.ModalForm:
 			// ModalForm expects boolean response
-			if modalResp, ok := params["modal_response"].(bool); ok {
+			if modalResp, ok := getBool(params, "modal_response"); ok {
 				response = modalResp
-			} else if idx, ok := getFloat(params, "button_index"); ok {
+			} else if idx, ok := getInt(params, "button_index"); ok {
 				response = idx == 0 // 0 = Button1 (true), 1 = Button2 (false)
 			} else {
 				response = true // Default: Button1
@@ -182,8 +388,8 @@ func registerBuiltinActions(r *Registry) {
 
 		case *types.ActionForm:
 			// ActionForm expects button index
-			if idx, ok := getFloat(params, "button_index"); ok {
-				response = int(idx)
+			if idx, ok := getInt(params, "button_index"); ok {
+				response = idx
 			} else if buttonText, ok := params["button_text"].(string); ok {
 				found := false
 				for i, btn := range f.Buttons {
@@ -201,8 +407,20 @@ func registerBuiltinActions(r *Registry) {
 			}
 
 		case *types.CustomForm:
-			// CustomForm expects array of values - for now just return empty array
-			response = []interface{}{}
+			// CustomForm expects one value per element
+			if raw, ok := params["values"]; ok && raw != nil {
+				values, ok := raw.([]interface{})
+				if !ok {
+					return fmt.Errorf("values は要素ごとの値のリストで指定してください (got %T: %v)", raw, raw)
+				}
+				customResponse, err := agent.CustomFormResponse(f, values)
+				if err != nil {
+					return err
+				}
+				response = customResponse
+			} else {
+				response = agent.CustomFormDefaults(f)
+			}
 
 		default:
 			return fmt.Errorf("不明なフォームタイプです")
@@ -211,6 +429,64 @@ func registerBuiltinActions(r *Registry) {
 		return a.SubmitForm(form.GetID(), response)
 	})
 
+	// submit_form_fields - Submit the last custom form by field label
+	r.RegisterAction("submit_form_fields", ActionDefinition{
+		Description: "最後に受信したCustomFormにフィールド名（ラベル）ごとの値で回答する。指定しなかったフィールドはデフォルト値のまま",
+		Parameters: []ParameterDef{
+			{Name: "fields", Type: "object", Required: true, Description: "ラベルと値の対応（例: {\"名前\": \"Steve\", \"PvP\": true, \"色\": \"赤\"}）"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		fields, ok := params["fields"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("fields parameter is required and must be a map")
+		}
+
+		form := a.GetLastForm()
+		if form == nil {
+			return fmt.Errorf("受信したフォームがありません")
+		}
+		if _, ok := form.(*types.CustomForm); !ok {
+			return fmt.Errorf("最後に受信したフォームはCustomFormではありません（%s）", form.GetType())
+		}
+
+		return a.SubmitCustomFormByLabel(form.GetID(), fields)
+	})
+
+	// read_form - Capture the last form's title and buttons into context variables
+	r.RegisterAction("read_form", ActionDefinition{
+		Description: "最後に受信したフォームのタイトル・本文・ボタンを読み取り、後続ステップで ${form.title}, ${form.content}, ${form.button.0} のように参照できるようにする",
+		Parameters:  []ParameterDef{},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		form := a.GetLastForm()
+		if form == nil {
+			return fmt.Errorf("受信したフォームがありません")
+		}
+
+		var content string
+		var buttons []string
+		switch f := form.(type) {
+		case *types.ModalForm:
+			content = f.Content
+			buttons = []string{f.Button1, f.Button2}
+		case *types.ActionForm:
+			content = f.Content
+			for _, btn := range f.Buttons {
+				buttons = append(buttons, btn.Text)
+			}
+		}
+
+		r.SetVariable("form.title", form.GetTitle())
+		r.SetVariable("form.content", content)
+		r.SetVariable("form.button_count", strconv.Itoa(len(buttons)))
+		r.SetVariable("form.buttons", strings.Join(buttons, "\n"))
+		for i, text := range buttons {
+			r.SetVariable(fmt.Sprintf("form.button.%d", i), text)
+		}
+
+		fmt.Printf("        [read_form] タイトル: %q, ボタン: %q\n", form.GetTitle(), buttons)
+		return nil
+	})
+
 	// close_form - Close a form without submitting
 	r.RegisterAction("close_form", ActionDefinition{
 		Description: "フォームを閉じる（キャンセル）",
@@ -249,12 +525,54 @@ func registerBuiltinAssertions(r *Registry) {
 		return nil
 	})
 
+	// assert_spawned - Assert that the agent has spawned
+	r.RegisterAssertion("assert_spawned", AssertionDefinition{
+		Description: "プレイヤーのスポーンが完了していることを確認する",
+		Parameters:  []ParameterDef{},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		if !a.IsSpawned() {
+			return fmt.Errorf("プレイヤーがまだスポーンしていません")
+		}
+		return nil
+	})
+
+	// assert_stay_connected - Assert that the agent stays connected for a duration
+	r.RegisterAssertion("assert_stay_connected", AssertionDefinition{
+		Description: "指定時間のあいだ切断されずに接続が維持されることを確認する",
+		Parameters: []ParameterDef{
+			{Name: "duration", Type: "duration", Required: true, Description: "接続を維持すべき時間（例: 10s）"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		duration, ok := getDuration(params, "duration")
+		if !ok {
+			return fmt.Errorf("duration parameter is required")
+		}
+		a.Expect().Connection().ToStayConnected(duration)
+		return nil
+	})
+
+	// assert_death - Assert that the agent dies
+	r.RegisterAssertion("assert_death", AssertionDefinition{
+		Description: "プレイヤーが死亡することを確認する（指定時間内の死亡を待機）",
+		Parameters: []ParameterDef{
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 10s）", Default: "10s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		timeout := 10 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeout = t
+		}
+		a.Expect().Death().ToOccur(timeout)
+		return nil
+	})
+
 	// assert_chat - Assert that a chat message is received
 	r.RegisterAssertion("assert_chat", AssertionDefinition{
 		Description: "指定パターンのチャットメッセージを受信することを確認する",
 		Parameters: []ParameterDef{
 			{Name: "pattern", Type: "string", Required: true, Description: "期待するパターン（部分一致）"},
-			{Name: "timeout", Type: "number", Required: false, Description: "タイムアウト秒数", Default: "5"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+			{Name: "directed_only", Type: "boolean", Required: false, Description: "自分宛て（ささやき、または自分の名前を含む）のメッセージのみ対象にする", Default: "false"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
 		pattern, ok := params["pattern"].(string)
@@ -262,13 +580,71 @@ func registerBuiltinAssertions(r *Registry) {
 			return fmt.Errorf("pattern parameter is required and must be a string")
 		}
 
-		timeout := 5.0
-		if t, ok := getFloat(params, "timeout"); ok {
-			timeout = t
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
 		}
 
-		timeoutDuration := time.Duration(timeout) * time.Second
-		a.Expect().Chat().ToReceive(pattern, timeoutDuration, nil)
+		var options *assertions.ChatOptions
+		if directed, ok := getBool(params, "directed_only"); ok && directed {
+			options = &assertions.ChatOptions{DirectedOnly: true}
+		}
+
+		// The message may have arrived while the previous step ran, so look at the history too
+		a.Expect().Chat().ToHaveSeen(pattern, timeoutDuration, options)
+		return nil
+	})
+
+	// assert_agent_received_chat - Assert that another agent receives a chat message
+	r.RegisterAssertion("assert_agent_received_chat", AssertionDefinition{
+		Description: "指定エージェントが指定パターンのチャットメッセージを受信することを確認する",
+		Parameters: []ParameterDef{
+			{Name: "recipient", Type: "string", Required: true, Description: "受信するエージェント名（connect_agent で指定した名前）"},
+			{Name: "pattern", Type: "string", Required: true, Description: "期待するパターン（部分一致）"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		recipient, err := resolveAgent(r, a, params, "recipient")
+		if err != nil {
+			return err
+		}
+		pattern, ok := params["pattern"].(string)
+		if !ok {
+			return fmt.Errorf("pattern parameter is required and must be a string")
+		}
+
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		// The message usually arrives while the sending step runs, before this assertion starts
+		recipient.Expect().Chat().ToHaveSeen(pattern, timeoutDuration, nil)
+		return nil
+	})
+
+	// assert_chat_translation - Assert that a translated message is received by key
+	r.RegisterAssertion("assert_chat_translation", AssertionDefinition{
+		Description: "翻訳キー（例: multiplayer.player.joined）でメッセージを受信することを確認する。サーバーの言語設定に依存しない",
+		Parameters: []ParameterDef{
+			{Name: "key", Type: "string", Required: true, Description: "翻訳キー"},
+			{Name: "args", Type: "array", Required: false, Description: "期待する翻訳パラメータ（順番通り、省略時は任意）"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		key, ok := params["key"].(string)
+		if !ok || key == "" {
+			return fmt.Errorf("key parameter is required and must be a string")
+		}
+
+		args, _ := getStringList(params, "args")
+
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().Chat().ToReceiveTranslation(key, args, timeoutDuration)
 		return nil
 	})
 
@@ -307,6 +683,7 @@ func registerBuiltinAssertions(r *Registry) {
 		Description: "ゲームモードを確認する",
 		Parameters: []ParameterDef{
 			{Name: "mode", Type: "string", Required: true, Description: "期待するゲームモード（survival, creative, adventure, spectator）"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "指定するとゲームモードの変更をこの時間まで待つ（例: 3s）"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
 		mode, ok := params["mode"].(string)
@@ -314,6 +691,21 @@ func registerBuiltinAssertions(r *Registry) {
 			return fmt.Errorf("mode parameter is required and must be a string")
 		}
 
+		if timeout, ok := getDuration(params, "timeout"); ok {
+			gamemodes := map[string]int32{
+				"survival":  assertions.GamemodeSurvival,
+				"creative":  assertions.GamemodeCreative,
+				"adventure": assertions.GamemodeAdventure,
+				"spectator": assertions.GamemodeSpectator,
+			}
+			gamemode, known := gamemodes[mode]
+			if !known {
+				return fmt.Errorf("unknown gamemode: %s", mode)
+			}
+			a.Expect().Gamemode().ToBeWithin(gamemode, timeout)
+			return nil
+		}
+
 		switch mode {
 		case "survival":
 			a.Expect().Gamemode().ToBeSurvival()
@@ -352,12 +744,29 @@ func registerBuiltinAssertions(r *Registry) {
 		return nil
 	})
 
+	// assert_position_corrected - Assert that the server pulls the player back
+	r.RegisterAssertion("assert_position_corrected", AssertionDefinition{
+		Description: "サーバーによって移動が補正される（引き戻される）ことを確認する",
+		Parameters: []ParameterDef{
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().Position().ToBeCorrected(timeoutDuration)
+		return nil
+	})
+
 	// assert_inventory_has_item - Assert that inventory contains an item
 	r.RegisterAssertion("assert_inventory_has_item", AssertionDefinition{
 		Description: "インベントリに指定アイテムがあることを確認する",
 		Parameters: []ParameterDef{
 			{Name: "item", Type: "string", Required: true, Description: "アイテム名またはID"},
 			{Name: "count", Type: "number", Required: false, Description: "期待する個数"},
+			{Name: "exact", Type: "boolean", Required: false, Description: "部分一致を使わず完全一致で比較する（例: goldがgold_ingotに一致しない）", Default: "false"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
 		item, ok := params["item"].(string)
@@ -365,10 +774,30 @@ func registerBuiltinAssertions(r *Registry) {
 			return fmt.Errorf("item parameter is required and must be a string")
 		}
 
-		if count, ok := getFloat(params, "count"); ok {
-			a.Expect().Inventory().ToHaveItemCount(item, int32(count))
+		inventory := a.Expect().Inventory()
+		if exact, ok := getBool(params, "exact"); ok && exact {
+			inventory = inventory.Exact()
+		}
+
+		if count, ok := getInt(params, "count"); ok {
+			inventory.ToHaveItemCount(item, int32(count))
 		} else {
-			a.Expect().Inventory().ToHaveItem(item)
+			inventory.ToHaveItem(item)
+		}
+		return nil
+	})
+
+	// assert_held_item - Assert the item in the selected hotbar slot
+	r.RegisterAssertion("assert_held_item", AssertionDefinition{
+		Description: "手に持っているアイテムを確認する（item省略時は何も持っていないこと）",
+		Parameters: []ParameterDef{
+			{Name: "item", Type: "string", Required: false, Description: "アイテム名またはID"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		if item, ok := params["item"].(string); ok && item != "" {
+			a.Expect().HeldItem().ToBe(item)
+		} else {
+			a.Expect().HeldItem().ToBeEmpty()
 		}
 		return nil
 	})
@@ -382,12 +811,78 @@ func registerBuiltinAssertions(r *Registry) {
 		return nil
 	})
 
+	// assert_sound - Assert that a sound is played
+	r.RegisterAssertion("assert_sound", AssertionDefinition{
+		Description: "サウンドが再生されることを確認する（例: random.levelup、レベルサウンドイベントは level_up など）",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "サウンド名"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, ok := params["name"].(string)
+		if !ok {
+			return fmt.Errorf("name parameter is required and must be a string")
+		}
+
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().Sound().ToPlay(name, timeoutDuration)
+		return nil
+	})
+
+	// assert_particle - Assert that a particle is spawned
+	r.RegisterAssertion("assert_particle", AssertionDefinition{
+		Description: "パーティクルが表示されることを確認する（名前は部分一致、例: heart）",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "パーティクル名"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, ok := params["name"].(string)
+		if !ok {
+			return fmt.Errorf("name parameter is required and must be a string")
+		}
+
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().Particle().ToSpawn(name, timeoutDuration)
+		return nil
+	})
+
+	// assert_boss_bar - Assert that a boss bar is shown
+	r.RegisterAssertion("assert_boss_bar", AssertionDefinition{
+		Description: "ボスバーが表示されることを確認する（タイトルは部分一致）",
+		Parameters: []ParameterDef{
+			{Name: "title", Type: "string", Required: true, Description: "ボスバーのタイトル"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		title, ok := params["title"].(string)
+		if !ok {
+			return fmt.Errorf("title parameter is required and must be a string")
+		}
+
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().BossBar().ToShow(title, timeoutDuration)
+		return nil
+	})
+
 	// assert_title - Assert that a title is received
 	r.RegisterAssertion("assert_title", AssertionDefinition{
 		Description: "タイトルが表示されることを確認する",
 		Parameters: []ParameterDef{
 			{Name: "text", Type: "string", Required: true, Description: "期待するタイトルテキスト"},
-			{Name: "timeout", Type: "number", Required: false, Description: "タイムアウト秒数", Default: "5"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
 		text, ok := params["text"].(string)
@@ -395,13 +890,13 @@ func registerBuiltinAssertions(r *Registry) {
 			return fmt.Errorf("text parameter is required and must be a string")
 		}
 
-		timeout := 5.0
-		if t, ok := getFloat(params, "timeout"); ok {
-			timeout = t
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
 		}
 
-		timeoutDuration := time.Duration(timeout) * time.Second
-		a.Expect().Title().ToReceive(text, timeoutDuration)
+		// The title may have been shown while the previous step ran, so look at the history too
+		a.Expect().Title().ToHaveSeen(text, timeoutDuration)
 		return nil
 	})
 
@@ -449,19 +944,62 @@ func registerBuiltinAssertions(r *Registry) {
 		return nil
 	})
 
+	// assert_player_joined - Assert that a player joins (or is already online)
+	r.RegisterAssertion("assert_player_joined", AssertionDefinition{
+		Description: "指定プレイヤーがサーバーに参加することを確認する（既に参加済みの場合も成功）",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "プレイヤー名"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 10s）", Default: "10s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, ok := params["name"].(string)
+		if !ok || name == "" {
+			return fmt.Errorf("name parameter is required and must be a string")
+		}
+
+		timeoutDuration := 10 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().PlayerList().ToSeeJoin(name, timeoutDuration)
+		return nil
+	})
+
+	// assert_player_left - Assert that a player leaves (or is already offline)
+	r.RegisterAssertion("assert_player_left", AssertionDefinition{
+		Description: "指定プレイヤーがサーバーから退出することを確認する（既に不在の場合も成功）",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "プレイヤー名"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 10s）", Default: "10s"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, ok := params["name"].(string)
+		if !ok || name == "" {
+			return fmt.Errorf("name parameter is required and must be a string")
+		}
+
+		timeoutDuration := 10 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
+		}
+
+		a.Expect().PlayerList().ToSeeLeave(name, timeoutDuration)
+		return nil
+	})
+
 	// assert_form_received - Assert that a form is received
 	r.RegisterAssertion("assert_form_received", AssertionDefinition{
 		Description: "フォームを受信することを確認する",
 		Parameters: []ParameterDef{
-			{Name: "timeout", Type: "number", Required: false, Description: "タイムアウト秒数", Default: "5"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "タイムアウト（例: 5s）", Default: "5s"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
-		timeout := 5.0
-		if t, ok := getFloat(params, "timeout"); ok {
-			timeout = t
+		timeoutDuration := 5 * time.Second
+		if t, ok := getDuration(params, "timeout"); ok {
+			timeoutDuration = t
 		}
 
-		timeoutDuration := time.Duration(timeout) * time.Second
 		a.Expect().Form().ToReceive(timeoutDuration)
 		return nil
 	})
@@ -499,6 +1037,29 @@ func registerBuiltinAssertions(r *Registry) {
 		return nil
 	})
 
+	// assert_position_equals_recorded - Assert that player is at a position stored by record_position
+	r.RegisterAssertion("assert_position_equals_recorded", AssertionDefinition{
+		Description: "record_positionで記録した位置にいることを確認する",
+		Parameters: []ParameterDef{
+			{Name: "name", Type: "string", Required: true, Description: "記録した位置の名前"},
+			{Name: "tolerance", Type: "number", Required: false, Description: "許容誤差", Default: "1"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		name, _ := params["name"].(string)
+		recorded, ok := r.GetNamedPosition(name)
+		if !ok {
+			return fmt.Errorf("位置 '%s' が記録されていません（先にrecord_positionを実行してください）", name)
+		}
+
+		tolerance := 1.0
+		if t, ok := getFloat(params, "tolerance"); ok {
+			tolerance = t
+		}
+
+		a.Expect().Position().ToBeNear(recorded, tolerance)
+		return nil
+	})
+
 	// assert_hunger_above - Assert that hunger is above a value
 	r.RegisterAssertion("assert_hunger_above", AssertionDefinition{
 		Description: "満腹度が指定値より大きいことを確認する",
@@ -547,7 +1108,7 @@ func registerBuiltinAssertions(r *Registry) {
 		if !ok {
 			return fmt.Errorf("objective parameter is required and must be a string")
 		}
-		expected, ok := getFloat(params, "value")
+		expected, ok := getInt(params, "value")
 		if !ok {
 			return fmt.Errorf("value parameter is required and must be a number")
 		}
@@ -579,20 +1140,104 @@ func registerBuiltinAssertions(r *Registry) {
 			distance = d
 		}
 
-		playerPos := a.Position()
-		entities := a.GetEntities()
-		for _, e := range entities {
+		nearest := a.GetNearestEntity(entityType)
+		if nearest == nil {
+			return fmt.Errorf("エンティティ '%s' が見つかりません", entityType)
+		}
+		if dist := state.DistanceTo(a.Position(), nearest.Position); dist > distance {
+			return fmt.Errorf("エンティティ '%s' が距離 %v 以内に見つかりません（最も近い個体: 距離 %.1f, ランタイムID %d）", entityType, distance, dist, nearest.RuntimeID)
+		}
+		return nil
+	})
+
+	// assert_agents_near - Assert that two agents are within a distance of each other
+	r.RegisterAssertion("assert_agents_near", AssertionDefinition{
+		Description: "2つのエージェントが指定距離以内にいることを確認する",
+		Parameters: []ParameterDef{
+			{Name: "agent1", Type: "string", Required: false, Description: "1つ目のエージェント名（省略時はこのステップのエージェント）"},
+			{Name: "agent2", Type: "string", Required: true, Description: "2つ目のエージェント名（connect_agent で指定した名前）"},
+			{Name: "distance", Type: "number", Required: false, Description: "許容距離", Default: "5"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		first, err := resolveAgent(r, a, params, "agent1")
+		if err != nil {
+			return err
+		}
+		second, err := resolveAgent(r, a, params, "agent2")
+		if err != nil {
+			return err
+		}
+		distance := 5.0
+		if d, ok := getFloat(params, "distance"); ok {
+			distance = d
+		}
+
+		if dist := state.DistanceTo(first.Position(), second.Position()); dist > distance {
+			return fmt.Errorf("エージェント %s と %s の距離が %v を超えています（実際: %.1f）", first.DisplayName(), second.DisplayName(), distance, dist)
+		}
+		return nil
+	})
+
+	// assert_entity_count - Assert the number of entities of a type nearby
+	r.RegisterAssertion("assert_entity_count", AssertionDefinition{
+		Description: "指定距離内にいる指定タイプのエンティティの数を確認する",
+		Parameters: []ParameterDef{
+			{Name: "type", Type: "string", Required: true, Description: "エンティティタイプ（例: minecraft:zombie）"},
+			{Name: "count", Type: "number", Required: true, Description: "期待する数"},
+			{Name: "distance", Type: "number", Required: false, Description: "検索距離", Default: "10"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		entityType, ok := params["type"].(string)
+		if !ok {
+			return fmt.Errorf("type parameter is required and must be a string")
+		}
+		count, ok := getInt(params, "count")
+		if !ok {
+			return fmt.Errorf("count parameter is required and must be a number")
+		}
+		distance := 10.0
+		if d, ok := getFloat(params, "distance"); ok {
+			distance = d
+		}
+
+		found := 0
+		for _, e := range a.GetNearbyEntities(distance) {
 			if e.Type == entityType {
-				dx := e.Position.X - playerPos.X
-				dy := e.Position.Y - playerPos.Y
-				dz := e.Position.Z - playerPos.Z
-				dist := dx*dx + dy*dy + dz*dz
-				if dist <= distance*distance {
-					return nil
-				}
+				found++
 			}
 		}
-		return fmt.Errorf("エンティティ '%s' が距離 %v 以内に見つかりません", entityType, distance)
+		if found != count {
+			return fmt.Errorf("距離 %v 以内のエンティティ '%s' の数が一致しません（期待: %d, 実際: %d）", distance, entityType, count, found)
+		}
+		return nil
+	})
+
+	// assert_block - Assert the block at a position
+	r.RegisterAssertion("assert_block", AssertionDefinition{
+		Description: "指定座標のブロックを確認する（timeout指定時はそのブロックになるまで待機）",
+		Parameters: []ParameterDef{
+			{Name: "x", Type: "number", Required: true, Description: "X座標"},
+			{Name: "y", Type: "number", Required: true, Description: "Y座標"},
+			{Name: "z", Type: "number", Required: true, Description: "Z座標"},
+			{Name: "block", Type: "string", Required: true, Description: "ブロック名（例: minecraft:chest）"},
+			{Name: "timeout", Type: "duration", Required: false, Description: "待機するタイムアウト（例: 5s）"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		block, ok := params["block"].(string)
+		if !ok {
+			return fmt.Errorf("block parameter is required and must be a string")
+		}
+		x, _ := getFloat(params, "x")
+		y, _ := getFloat(params, "y")
+		z, _ := getFloat(params, "z")
+
+		assertion := a.Expect().Block(types.Position{X: x, Y: y, Z: z})
+		if timeout, ok := getDuration(params, "timeout"); ok {
+			assertion.ToBecome(block, timeout)
+		} else {
+			assertion.ToBe(block)
+		}
+		return nil
 	})
 
 	// assert_form_title - Assert form has specific title
@@ -652,6 +1297,81 @@ func registerBuiltinAssertions(r *Registry) {
 		return fmt.Errorf("ボタン '%s' がフォームに見つかりません", text)
 	})
 
+	// assert_form_toggle_default - Assert custom form toggle default state
+	r.RegisterAssertion("assert_form_toggle_default", AssertionDefinition{
+		Description: "カスタムフォームのトグルの初期値を確認する",
+		Parameters: []ParameterDef{
+			{Name: "label", Type: "string", Required: true, Description: "トグルのラベル"},
+			{Name: "value", Type: "boolean", Required: true, Description: "期待する初期値"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		label, _ := params["label"].(string)
+		expected, ok := getBool(params, "value")
+		if !ok {
+			return fmt.Errorf("value parameter is required and must be a boolean")
+		}
+		a.Expect().Form().ToHaveReceived().ToHaveToggleDefault(label, expected)
+		return nil
+	})
+
+	// assert_form_slider_range - Assert custom form slider range
+	r.RegisterAssertion("assert_form_slider_range", AssertionDefinition{
+		Description: "カスタムフォームのスライダーの範囲を確認する",
+		Parameters: []ParameterDef{
+			{Name: "label", Type: "string", Required: true, Description: "スライダーのラベル"},
+			{Name: "min", Type: "number", Required: true, Description: "期待する最小値"},
+			{Name: "max", Type: "number", Required: true, Description: "期待する最大値"},
+			{Name: "step", Type: "number", Required: false, Description: "期待するステップ幅"},
+			{Name: "default", Type: "number", Required: false, Description: "期待する初期値"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		label, _ := params["label"].(string)
+		min, ok := getFloat(params, "min")
+		if !ok {
+			return fmt.Errorf("min parameter is required and must be a number")
+		}
+		max, ok := getFloat(params, "max")
+		if !ok {
+			return fmt.Errorf("max parameter is required and must be a number")
+		}
+		form := a.Expect().Form().ToHaveReceived().ToHaveSliderRange(label, min, max)
+		if step, ok := getFloat(params, "step"); ok {
+			form.ToHaveSliderStep(label, step)
+		}
+		if def, ok := getFloat(params, "default"); ok {
+			form.ToHaveSliderDefault(label, def)
+		}
+		return nil
+	})
+
+	// assert_form_input_default - Assert custom form input default value
+	r.RegisterAssertion("assert_form_input_default", AssertionDefinition{
+		Description: "カスタムフォームの入力欄の初期値を確認する",
+		Parameters: []ParameterDef{
+			{Name: "label", Type: "string", Required: true, Description: "入力欄のラベル"},
+			{Name: "value", Type: "string", Required: true, Description: "期待する初期値"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		label, _ := params["label"].(string)
+		expected, _ := params["value"].(string)
+		a.Expect().Form().ToHaveReceived().ToHaveInputDefault(label, expected)
+		return nil
+	})
+
+	// assert_form_dropdown_default - Assert custom form dropdown default option
+	r.RegisterAssertion("assert_form_dropdown_default", AssertionDefinition{
+		Description: "カスタムフォームのドロップダウンの初期選択を確認する",
+		Parameters: []ParameterDef{
+			{Name: "label", Type: "string", Required: true, Description: "ドロップダウンのラベル"},
+			{Name: "option", Type: "string", Required: true, Description: "期待する初期選択肢"},
+		},
+	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
+		label, _ := params["label"].(string)
+		expected, _ := params["option"].(string)
+		a.Expect().Form().ToHaveReceived().ToHaveDropdownDefault(label, expected)
+		return nil
+	})
+
 	// assert_permission_level - Assert player has specific permission level
 	r.RegisterAssertion("assert_permission_level", AssertionDefinition{
 		Description: "プレイヤーの権限レベルを確認する",
@@ -659,7 +1379,7 @@ func registerBuiltinAssertions(r *Registry) {
 			{Name: "level", Type: "number", Required: true, Description: "期待する権限レベル（0-4）"},
 		},
 	}, func(ctx context.Context, a *agent.Agent, params map[string]interface{}) error {
-		expected, ok := getFloat(params, "level")
+		expected, ok := getInt(params, "level")
 		if !ok {
 			return fmt.Errorf("level parameter is required and must be a number")
 		}
@@ -671,7 +1391,40 @@ func registerBuiltinAssertions(r *Registry) {
 	})
 }
 
-// getFloat extracts a float64 from params, handling both float64 and int types
+// resolveAgent returns the agent named by the key param, or a if the param is empty
+// Named agents are the ones added by connect_agent.
+func resolveAgent(r *Registry, a *agent.Agent, params map[string]interface{}, key string) (*agent.Agent, error) {
+	name, _ := params[key].(string)
+	if name == "" {
+		return a, nil
+	}
+	named, ok := r.GetAgent(name)
+	if !ok {
+		return nil, fmt.Errorf("agent not found: %s (connect it with connect_agent first)", name)
+	}
+	return named, nil
+}
+
+// findNearestEntity returns the nearest entity of the "type" parameter within the "distance" parameter
+func findNearestEntity(a *agent.Agent, params map[string]interface{}) (*types.Entity, error) {
+	entityType, _ := params["type"].(string)
+	distance := 6.0
+	if d, ok := getFloat(params, "distance"); ok {
+		distance = d
+	}
+
+	nearest := a.GetNearestEntity(entityType)
+	if nearest == nil {
+		return nil, fmt.Errorf("エンティティ '%s' が見つかりません", entityType)
+	}
+	if dist := state.DistanceTo(a.Position(), nearest.Position); dist > distance {
+		return nil, fmt.Errorf("エンティティ '%s' が距離 %v 以内に見つかりません（最も近い個体: 距離 %.1f, ランタイムID %d）", entityType, distance, dist, nearest.RuntimeID)
+	}
+	return nearest, nil
+}
+
+// getFloat extracts a float64 from params, handling numeric types and numeric strings
+// LLM output frequently stringifies numbers (e.g. "10"), so strings are parsed too
 func getFloat(params map[string]interface{}, key string) (float64, bool) {
 	val, ok := params[key]
 	if !ok {
@@ -687,8 +1440,119 @@ func getFloat(params map[string]interface{}, key string) (float64, bool) {
 		return float64(v), true
 	case float32:
 		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false
+		}
+		return f, true
+	default:
+		return 0, false
+	}
+}
+
+// getInt extracts an int from params using the same coercion rules as getFloat
+// Fractional values are truncated
+func getInt(params map[string]interface{}, key string) (int, bool) {
+	f, ok := getFloat(params, key)
+	if !ok {
+		return 0, false
+	}
+	return int(f), true
+}
+
+// getBool extracts a bool from params, handling bool values and "true"/"false" strings
+func getBool(params map[string]interface{}, key string) (bool, bool) {
+	val, ok := params[key]
+	if !ok {
+		return false, false
+	}
+
+	switch v := val.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, false
+		}
+		return b, true
+	default:
+		return false, false
+	}
+}
+
+// getString extracts a string from params
+// Numbers and booleans, as YAML and LLM JSON often give them for string fields, are formatted as strings
+func getString(params map[string]interface{}, key string) (string, bool) {
+	switch v := params[key].(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
 	default:
+		return "", false
+	}
+}
+
+// getBlockFace extracts a block face given by name ("up") or protocol number (1) from params
+func getBlockFace(params map[string]interface{}, key string) (types.BlockFace, bool) {
+	if name, ok := params[key].(string); ok {
+		if face, ok := types.ParseBlockFace(strings.ToLower(strings.TrimSpace(name))); ok {
+			return face, true
+		}
+	}
+
+	n, ok := getInt(params, key)
+	if !ok || n < int(types.BlockFaceDown) || n > int(types.BlockFaceEast) {
 		return 0, false
 	}
+	return types.BlockFace(n), true
 }
 
+// getStringList extracts a list of strings from params
+// Both arrays and comma-separated strings are accepted
+func getStringList(params map[string]interface{}, key string) ([]string, bool) {
+	switch v := params[key].(type) {
+	case []interface{}:
+		list := make([]string, len(v))
+		for i, item := range v {
+			list[i] = fmt.Sprintf("%v", item)
+		}
+		return list, true
+	case []string:
+		return v, true
+	case string:
+		parts := strings.Split(v, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		return parts, true
+	default:
+		return nil, false
+	}
+}
+
+// getDuration extracts a time.Duration from params
+// Strings are parsed with time.ParseDuration (e.g. "2s", "500ms"); plain numbers are treated as seconds
+func getDuration(params map[string]interface{}, key string) (time.Duration, bool) {
+	if s, ok := params[key].(string); ok {
+		if d, err := time.ParseDuration(strings.TrimSpace(s)); err == nil {
+			return d, true
+		}
+	}
+
+	seconds, ok := getFloat(params, key)
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
@@ -3,13 +3,20 @@ package actions
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"sync"
 
 	"github.com/gollilla/best/pkg/agent"
 	"github.com/gollilla/best/pkg/types"
 )
 
+// ErrInvalidStep is wrapped by errors for steps that cannot run as written: an unknown action or
+// assertion name, or params that do not match its definition
+var ErrInvalidStep = errors.New("invalid step")
+
 // ActionDefinition defines an action that can be executed by the scenario engine
 type ActionDefinition struct {
 	Name        string         `json:"name"`
@@ -56,41 +63,104 @@ type Registry struct {
 	mu          sync.RWMutex
 	actions     map[string]ActionEntry
 	assertions  map[string]AssertionEntry
+	// Names registered by NewRegistry, used to warn when a builtin is overridden
+	builtinActions    map[string]bool
+	builtinAssertions map[string]bool
 	// Scenario context state
 	lastPosition *types.Position
+	positions    map[string]types.Position // named positions stored by record_position
+	variables    map[string]string         // set by steps such as read_form, referenced as ${name} in params
+	agents       map[string]*agent.Agent   // additional agents connected by connect_agent, selected by a step's agent param
 }
 
+// variablePattern matches ${name} references in string params
+var variablePattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
 // NewRegistry creates a new action/assertion registry with builtin actions
 func NewRegistry() *Registry {
 	r := &Registry{
 		actions:    make(map[string]ActionEntry),
 		assertions: make(map[string]AssertionEntry),
+		positions:  make(map[string]types.Position),
+		variables:  make(map[string]string),
+		agents:     make(map[string]*agent.Agent),
 	}
 
 	// Register builtin actions and assertions
 	registerBuiltinActions(r)
 	registerBuiltinAssertions(r)
 
+	r.builtinActions = make(map[string]bool, len(r.actions))
+	for name := range r.actions {
+		r.builtinActions[name] = true
+	}
+	r.builtinAssertions = make(map[string]bool, len(r.assertions))
+	for name := range r.assertions {
+		r.builtinAssertions[name] = true
+	}
+
 	return r
 }
 
 // RegisterAction registers a new action
+// An existing action with the same name is overwritten (a warning is printed for builtins)
 func (r *Registry) RegisterAction(name string, def ActionDefinition, fn ActionFunc) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	def.Name = name
-	r.actions[name] = ActionEntry{
-		Definition: def,
-		Execute:    fn,
+	if r.builtinActions[name] {
+		fmt.Printf("Warning: action %q overrides a builtin action\n", name)
 	}
+	r.setAction(name, def, fn)
+}
+
+// RegisterActionStrict registers a new action, returning an error if the name is already registered
+func (r *Registry) RegisterActionStrict(name string, def ActionDefinition, fn ActionFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.actions[name]; exists {
+		return fmt.Errorf("action already registered: %s", name)
+	}
+	r.setAction(name, def, fn)
+	return nil
 }
 
 // RegisterAssertion registers a new assertion
+// An existing assertion with the same name is overwritten (a warning is printed for builtins)
 func (r *Registry) RegisterAssertion(name string, def AssertionDefinition, fn AssertionFunc) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if r.builtinAssertions[name] {
+		fmt.Printf("Warning: assertion %q overrides a builtin assertion\n", name)
+	}
+	r.setAssertion(name, def, fn)
+}
+
+// RegisterAssertionStrict registers a new assertion, returning an error if the name is already registered
+func (r *Registry) RegisterAssertionStrict(name string, def AssertionDefinition, fn AssertionFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.assertions[name]; exists {
+		return fmt.Errorf("assertion already registered: %s", name)
+	}
+	r.setAssertion(name, def, fn)
+	return nil
+}
+
+// setAction stores an action entry (caller must hold the lock)
+func (r *Registry) setAction(name string, def ActionDefinition, fn ActionFunc) {
+	def.Name = name
+	r.actions[name] = ActionEntry{
+		Definition: def,
+		Execute:    fn,
+	}
+}
+
+// setAssertion stores an assertion entry (caller must hold the lock)
+func (r *Registry) setAssertion(name string, def AssertionDefinition, fn AssertionFunc) {
 	def.Name = name
 	r.assertions[name] = AssertionEntry{
 		Definition: def,
@@ -117,26 +187,39 @@ func (r *Registry) GetAssertion(name string) (AssertionEntry, bool) {
 }
 
 // ExecuteAction executes an action by name
+// Params are validated against the action definition before the action runs
 func (r *Registry) ExecuteAction(ctx context.Context, agent *agent.Agent, name string, params map[string]interface{}) error {
 	entry, ok := r.GetAction(name)
 	if !ok {
-		return fmt.Errorf("action not found: %s", name)
+		return fmt.Errorf("%w: action not found: %s", ErrInvalidStep, name)
+	}
+
+	params = r.expandVariables(params)
+	if err := validateParams("action", name, entry.Definition.Parameters, params); err != nil {
+		return err
 	}
 
 	return entry.Execute(ctx, agent, params)
 }
 
 // ExecuteAssertion executes an assertion by name
+// Params are validated against the assertion definition before the assertion runs
 func (r *Registry) ExecuteAssertion(ctx context.Context, agent *agent.Agent, name string, params map[string]interface{}) error {
 	entry, ok := r.GetAssertion(name)
 	if !ok {
-		return fmt.Errorf("assertion not found: %s", name)
+		return fmt.Errorf("%w: assertion not found: %s", ErrInvalidStep, name)
+	}
+
+	params = r.expandVariables(params)
+	if err := validateParams("assertion", name, entry.Definition.Parameters, params); err != nil {
+		return err
 	}
 
 	return entry.Assert(ctx, agent, params)
 }
 
-// GetActionDefinitions returns all action definitions
+// GetActionDefinitions returns all action definitions sorted by name
+// The order is stable so that generated prompts are reproducible
 func (r *Registry) GetActionDefinitions() []ActionDefinition {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -145,10 +228,13 @@ func (r *Registry) GetActionDefinitions() []ActionDefinition {
 	for _, entry := range r.actions {
 		defs = append(defs, entry.Definition)
 	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Name < defs[j].Name
+	})
 	return defs
 }
 
-// GetAssertionDefinitions returns all assertion definitions
+// GetAssertionDefinitions returns all assertion definitions sorted by name
 func (r *Registry) GetAssertionDefinitions() []AssertionDefinition {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -157,6 +243,9 @@ func (r *Registry) GetAssertionDefinitions() []AssertionDefinition {
 	for _, entry := range r.assertions {
 		defs = append(defs, entry.Definition)
 	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Name < defs[j].Name
+	})
 	return defs
 }
 
@@ -192,9 +281,121 @@ func (r *Registry) GetLastPosition() *types.Position {
 	return r.lastPosition
 }
 
+// SetNamedPosition stores a position under a name for later comparison
+func (r *Registry) SetNamedPosition(name string, pos types.Position) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.positions[name] = pos
+}
+
+// GetNamedPosition returns a position stored by SetNamedPosition
+func (r *Registry) GetNamedPosition(name string) (types.Position, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pos, ok := r.positions[name]
+	return pos, ok
+}
+
+// SetVariable stores a scenario context variable
+func (r *Registry) SetVariable(name, value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.variables[name] = value
+}
+
+// GetVariable returns a scenario context variable
+func (r *Registry) GetVariable(name string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	value, ok := r.variables[name]
+	return value, ok
+}
+
+// AddAgent registers an additional agent under name for steps selecting it with the agent param
+func (r *Registry) AddAgent(name string, a *agent.Agent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.agents[name]; exists {
+		return fmt.Errorf("agent already registered: %s", name)
+	}
+	r.agents[name] = a
+	return nil
+}
+
+// GetAgent returns an agent registered by AddAgent
+func (r *Registry) GetAgent(name string) (*agent.Agent, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.agents[name]
+	return a, ok
+}
+
+// DisconnectAgents disconnects and forgets all agents registered by AddAgent
+func (r *Registry) DisconnectAgents() {
+	r.mu.Lock()
+	agents := r.agents
+	r.agents = make(map[string]*agent.Agent)
+	r.mu.Unlock()
+
+	for _, a := range agents {
+		if a.IsConnected() {
+			_ = a.Disconnect()
+		}
+	}
+}
+
+// expandVariables returns a copy of params with ${name} references in string values replaced
+// Nested maps and lists (e.g. form responses or item lists) are expanded recursively.
+// References to unknown variables are left unchanged
+func (r *Registry) expandVariables(params map[string]interface{}) map[string]interface{} {
+	if len(params) == 0 {
+		return params
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expanded := make(map[string]interface{}, len(params))
+	for key, val := range params {
+		expanded[key] = r.expandValue(val)
+	}
+	return expanded
+}
+
+// expandValue expands variable references in a single param value
+// The caller must hold r.mu
+func (r *Registry) expandValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case string:
+		return variablePattern.ReplaceAllStringFunc(v, func(ref string) string {
+			if value, ok := r.variables[ref[2:len(ref)-1]]; ok {
+				return value
+			}
+			return ref
+		})
+	case map[string]interface{}:
+		expanded := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			expanded[key] = r.expandValue(item)
+		}
+		return expanded
+	case []interface{}:
+		expanded := make([]interface{}, len(v))
+		for i, item := range v {
+			expanded[i] = r.expandValue(item)
+		}
+		return expanded
+	default:
+		return val
+	}
+}
+
 // ClearContext clears the scenario context state
 func (r *Registry) ClearContext() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.lastPosition = nil
+	r.positions = make(map[string]types.Position)
+	r.variables = make(map[string]string)
 }
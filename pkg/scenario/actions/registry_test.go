@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandVariables(t *testing.T) {
+	r := NewRegistry()
+	r.SetVariable("button", "Shop")
+	r.SetVariable("name", "Bot")
+
+	params := map[string]interface{}{
+		"text":  "hello ${name}",
+		"count": 3,
+		"response": map[string]interface{}{
+			"label":  "${button}",
+			"values": []interface{}{"${name}", 1, "${missing}"},
+		},
+		"items": []interface{}{map[string]interface{}{"id": "${name}"}},
+	}
+
+	got := r.expandVariables(params)
+	want := map[string]interface{}{
+		"text":  "hello Bot",
+		"count": 3,
+		"response": map[string]interface{}{
+			"label":  "Shop",
+			"values": []interface{}{"Bot", 1, "${missing}"},
+		},
+		"items": []interface{}{map[string]interface{}{"id": "Bot"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandVariables() = %#v, want %#v", got, want)
+	}
+
+	// The original params must not be modified
+	if params["response"].(map[string]interface{})["label"] != "${button}" {
+		t.Error("expandVariables modified the nested input map")
+	}
+}
+
+func TestValidateParamsStringifiesScalars(t *testing.T) {
+	defs := []ParameterDef{{Name: "message", Type: "string", Required: true}}
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "string", value: "hello", want: "hello"},
+		{name: "float", value: 42.0, want: "42"},
+		{name: "fraction", value: 1.5, want: "1.5"},
+		{name: "int", value: 7, want: "7"},
+		{name: "bool", value: true, want: "true"},
+		{name: "list", value: []interface{}{"a"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]interface{}{"message": tt.value}
+			err := validateParams("action", "chat", defs, params)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("validateParams accepted %v", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateParams: %v", err)
+			}
+			if params["message"] != tt.want {
+				t.Errorf("message = %#v, want %q", params["message"], tt.want)
+			}
+		})
+	}
+}
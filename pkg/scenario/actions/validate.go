@@ -0,0 +1,54 @@
+package actions
+
+import "fmt"
+
+// validateParams checks params against the parameter definitions before execution
+// kind is "action" or "assertion" and is only used for error messages
+// Numbers and booleans given for string parameters are replaced with their string form in params,
+// so params must be a map the caller owns (such as the copy made by expandVariables)
+func validateParams(kind, name string, defs []ParameterDef, params map[string]interface{}) error {
+	for _, def := range defs {
+		val, ok := params[def.Name]
+		if !ok || val == nil {
+			if def.Required {
+				return fmt.Errorf("%w: %s '%s' missing required parameter '%s'", ErrInvalidStep, kind, name, def.Name)
+			}
+			continue
+		}
+
+		if !isCoercible(params, def) {
+			return fmt.Errorf("%w: %s '%s' parameter '%s' must be a %s (got %T: %v)", ErrInvalidStep, kind, name, def.Name, def.Type, val, val)
+		}
+		if def.Type == "string" {
+			params[def.Name], _ = getString(params, def.Name)
+		}
+	}
+	return nil
+}
+
+// isCoercible reports whether the param value can be used as the definition's type
+func isCoercible(params map[string]interface{}, def ParameterDef) bool {
+	switch def.Type {
+	case "number":
+		_, ok := getFloat(params, def.Name)
+		return ok
+	case "boolean":
+		_, ok := getBool(params, def.Name)
+		return ok
+	case "duration":
+		_, ok := getDuration(params, def.Name)
+		return ok
+	case "string":
+		_, ok := getString(params, def.Name)
+		return ok
+	case "array":
+		_, ok := getStringList(params, def.Name)
+		return ok
+	case "object":
+		_, ok := params[def.Name].(map[string]interface{})
+		return ok
+	default:
+		// Unknown types are left to the action itself
+		return true
+	}
+}
@@ -2,6 +2,7 @@ package scenario
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,9 @@ import (
 	"github.com/gollilla/best/pkg/scenario/actions"
 )
 
+// ErrStepCancelled is wrapped by the error of a step interrupted by cancellation of the run
+var ErrStepCancelled = errors.New("step cancelled")
+
 // Executor executes scenario steps
 type Executor struct {
 	agent    *agent.Agent
@@ -24,6 +28,11 @@ type ExecutorOptions struct {
 	Verbose     bool
 	OnStepStart func(stepNum int, step ScenarioStep)
 	OnStepEnd   func(stepNum int, result StepResult)
+	// Corrector suggests a replacement for a failed step; the corrected step is retried once
+	// Failed actions and steps rejected as invalid are corrected; failed assertions never are.
+	Corrector func(ctx context.Context, step ScenarioStep, result StepResult) (*ScenarioStep, error)
+	// ContinueOnFailure runs the remaining steps after a failed assertion
+	ContinueOnFailure bool
 }
 
 // DefaultExecutorOptions returns default executor options
@@ -58,10 +67,19 @@ func (e *Executor) Execute(ctx context.Context, steps []ScenarioStep) (*Result,
 
 	startTime := time.Now()
 
+	// Reset per-scenario context (last position, variables)
+	e.registry.ClearContext()
+	// Chat and title assertions look at the history, which must not hold earlier scenarios' messages
+	e.agent.ClearHistory()
+	// Agents added by connect_agent only live for this run
+	defer e.registry.DisconnectAgents()
+
 	// Create timeout context for the entire execution
 	execCtx, cancel := context.WithTimeout(ctx, e.options.Timeout)
 	defer cancel()
 
+	var failures []error
+
 	for i, step := range steps {
 		stepNum := i + 1
 
@@ -71,6 +89,9 @@ func (e *Executor) Execute(ctx context.Context, steps []ScenarioStep) (*Result,
 		}
 
 		stepResult := e.executeStep(execCtx, stepNum, step)
+		if stepResult.Status == StepStatusFailed && e.options.Corrector != nil && e.isCorrectable(step, stepResult) {
+			stepResult = e.retryCorrected(execCtx, stepNum, step, stepResult)
+		}
 		result.Steps = append(result.Steps, stepResult)
 
 		// Notify step end
@@ -78,22 +99,49 @@ func (e *Executor) Execute(ctx context.Context, steps []ScenarioStep) (*Result,
 			e.options.OnStepEnd(stepNum, stepResult)
 		}
 
+		// An aborted run is not a failure of the step that happened to be running
+		if stepResult.Status == StepStatusCancelled {
+			result.Cancelled = true
+			if result.Error == nil {
+				result.Error = stepResult.Error
+			}
+			failures = append(failures, fmt.Errorf("step %d: %w", stepNum, stepResult.Error))
+			break
+		}
+
 		if stepResult.Status == StepStatusFailed {
 			result.FailedSteps++
 			result.Success = false
-			result.Error = stepResult.Error
-			break
+			if result.Error == nil {
+				result.Error = stepResult.Error
+			}
+			failures = append(failures, fmt.Errorf("step %d: %w", stepNum, stepResult.Error))
+
+			// Soft assertions: keep going unless an action failed or time ran out
+			if !e.options.ContinueOnFailure || !e.isAssertion(step.Action) || execCtx.Err() != nil {
+				break
+			}
+			continue
 		}
 
 		result.PassedSteps++
 
 		// Check if context was cancelled
 		if execCtx.Err() != nil {
-			result.Error = execCtx.Err()
+			result.Cancelled = errors.Is(execCtx.Err(), context.Canceled)
+			if result.Error == nil {
+				result.Error = execCtx.Err()
+			}
+			failures = append(failures, execCtx.Err())
 			break
 		}
 	}
 
+	// With ContinueOnFailure several steps may fail; report all of them
+	if len(failures) > 1 {
+		result.Error = errors.Join(failures...)
+	}
+
 	result.Duration = time.Since(startTime)
 	result.Success = result.FailedSteps == 0 && result.Error == nil
 
@@ -125,7 +173,10 @@ func (e *Executor) executeStep(ctx context.Context, stepNum int, step ScenarioSt
 
 	result.Duration = time.Since(startTime)
 
-	if err != nil {
+	if errors.Is(err, ErrStepCancelled) {
+		result.Status = StepStatusCancelled
+		result.Error = err
+	} else if err != nil {
 		result.Status = StepStatusFailed
 		result.Error = err
 	} else {
@@ -135,6 +186,38 @@ func (e *Executor) executeStep(ctx context.Context, stepNum int, step ScenarioSt
 	return result
 }
 
+// retryCorrected asks the corrector for a replacement step and executes it once
+// The original failed result is returned if no correction is available
+func (e *Executor) retryCorrected(ctx context.Context, stepNum int, step ScenarioStep, failed StepResult) StepResult {
+	corrected, err := e.options.Corrector(ctx, step, failed)
+	if err != nil || corrected == nil {
+		if e.options.Verbose && err != nil {
+			fmt.Printf("  Step %d: self-correction failed: %v\n", stepNum, err)
+		}
+		return failed
+	}
+
+	if e.options.Verbose {
+		fmt.Printf("  Step %d failed (%v), retrying with corrected step: %s %v\n", stepNum, failed.Error, corrected.Action, corrected.Params)
+	}
+
+	retried := e.executeStep(ctx, stepNum, *corrected)
+	retried.Duration += failed.Duration
+	retried.Corrected = true
+	retried.OriginalError = failed.Error
+	if retried.Status == StepStatusFailed {
+		retried.Error = fmt.Errorf("%w (original error: %v)", retried.Error, failed.Error)
+	}
+	return retried
+}
+
+// isCorrectable reports whether a failed step may be replaced by a self-corrected one
+// A failed assertion is a test result, and letting the LLM rewrite its expected value until it
+// passes would hide the failure, so assertions are only corrected when they could not run at all.
+func (e *Executor) isCorrectable(step ScenarioStep, failed StepResult) bool {
+	return !e.isAssertion(step.Action) || errors.Is(failed.Error, actions.ErrInvalidStep)
+}
+
 // isAssertion checks if the action name is an assertion
 func (e *Executor) isAssertion(name string) bool {
 	return strings.HasPrefix(name, "assert_") || e.registry.IsAssertion(name)
@@ -142,44 +225,59 @@ func (e *Executor) isAssertion(name string) bool {
 
 // executeAction executes an action
 func (e *Executor) executeAction(ctx context.Context, step ScenarioStep) error {
-	// Recover from panics (assertions might panic)
-	var panicErr error
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				if err, ok := r.(error); ok {
-					panicErr = err
-				} else {
-					panicErr = fmt.Errorf("%v", r)
-				}
-			}
-		}()
-
-		panicErr = e.registry.ExecuteAction(ctx, e.agent, step.Action, step.Params)
-	}()
-
-	return panicErr
+	return runRecovered(ctx, func() error {
+		a, err := e.stepAgent(step)
+		if err != nil {
+			return err
+		}
+		return e.registry.ExecuteAction(ctx, a, step.Action, step.Params)
+	})
 }
 
 // executeAssertion executes an assertion
 func (e *Executor) executeAssertion(ctx context.Context, step ScenarioStep) error {
-	// Recover from panics (assertions might panic)
-	var panicErr error
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				if err, ok := r.(error); ok {
-					panicErr = err
-				} else {
-					panicErr = fmt.Errorf("%v", r)
-				}
+	return runRecovered(ctx, func() error {
+		a, err := e.stepAgent(step)
+		if err != nil {
+			return err
+		}
+		return e.registry.ExecuteAssertion(ctx, a, step.Action, step.Params)
+	})
+}
+
+// stepAgent returns the agent a step runs on
+// Steps without an agent param use the executor's agent; others select one added by connect_agent.
+func (e *Executor) stepAgent(step ScenarioStep) (*agent.Agent, error) {
+	name, _ := step.Params["agent"].(string)
+	if name == "" {
+		return e.agent, nil
+	}
+	a, ok := e.registry.GetAgent(name)
+	if !ok {
+		return nil, fmt.Errorf("agent not found: %s (connect it with connect_agent first)", name)
+	}
+	return a, nil
+}
+
+// runRecovered runs a step, converting panics (assertions might panic) into errors
+// A step that fails after ctx was cancelled returns an error wrapping ErrStepCancelled.
+// Deadlines (step or scenario timeouts) are not cancellations and stay failures.
+func runRecovered(ctx context.Context, fn func() error) (stepErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if err, ok := r.(error); ok {
+				stepErr = err
+			} else {
+				stepErr = fmt.Errorf("%v", r)
 			}
-		}()
+		}
 
-		panicErr = e.registry.ExecuteAssertion(ctx, e.agent, step.Action, step.Params)
+		if stepErr != nil && errors.Is(ctx.Err(), context.Canceled) {
+			stepErr = fmt.Errorf("%w: %v", ErrStepCancelled, stepErr)
+		}
 	}()
 
-	return panicErr
+	return fn()
 }
 
 // GetRegistry returns the action/assertion registry
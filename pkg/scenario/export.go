@@ -0,0 +1,225 @@
+package scenario
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Report formats supported by Summary.WriteReport
+const (
+	ReportFormatText     = "txt"
+	ReportFormatJSON     = "json"
+	ReportFormatMarkdown = "md"
+)
+
+// reportJSON is the JSON representation of a Summary
+// Errors are flattened to strings so they survive marshaling
+type reportJSON struct {
+	Success        bool                 `json:"success"`
+	TotalScenarios int                  `json:"totalScenarios"`
+	PassedCount    int                  `json:"passedCount"`
+	FailedCount    int                  `json:"failedCount"`
+	CancelledCount int                  `json:"cancelledCount"`
+	TotalSteps     int                  `json:"totalSteps"`
+	PassedSteps    int                  `json:"passedSteps"`
+	FailedSteps    int                  `json:"failedSteps"`
+	TotalDuration  string               `json:"totalDuration"`
+	Text           string               `json:"summaryText,omitempty"`
+	Scenarios      []scenarioReportJSON `json:"scenarios"`
+}
+
+type scenarioReportJSON struct {
+	Scenario    string           `json:"scenario"`
+	Success     bool             `json:"success"`
+	Cancelled   bool             `json:"cancelled,omitempty"`
+	TotalSteps  int              `json:"totalSteps"`
+	PassedSteps int              `json:"passedSteps"`
+	FailedSteps int              `json:"failedSteps"`
+	Duration    string           `json:"duration"`
+	Error       string           `json:"error,omitempty"`
+	Steps       []stepReportJSON `json:"steps"`
+}
+
+type stepReportJSON struct {
+	StepNumber  int    `json:"stepNumber"`
+	Action      string `json:"action"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Duration    string `json:"duration"`
+	Error       string `json:"error,omitempty"`
+}
+
+// WriteReport writes the summary to a file in the given format ("txt", "json" or "md")
+// The natural-language summary (Summary.Text) is included when it has been generated
+func (s *Summary) WriteReport(path string, format string) error {
+	var data []byte
+	var err error
+
+	switch strings.ToLower(format) {
+	case ReportFormatText, "text":
+		data = s.formatText()
+	case ReportFormatJSON:
+		data, err = s.formatJSON()
+	case ReportFormatMarkdown, "markdown":
+		data = s.formatMarkdown()
+	default:
+		return fmt.Errorf("unsupported report format: %s (supported: txt, json, md)", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to format report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+
+	return nil
+}
+
+// formatText renders the summary using the console reporter layout
+func (s *Summary) formatText() []byte {
+	var buf bytes.Buffer
+	NewConsoleReporterWithWriter(&buf).ReportSummary(s)
+
+	if s.Text != "" {
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, s.Text)
+	}
+
+	return buf.Bytes()
+}
+
+// formatJSON renders the summary as indented JSON
+func (s *Summary) formatJSON() ([]byte, error) {
+	report := reportJSON{
+		Success:        s.Success(),
+		TotalScenarios: s.TotalScenarios,
+		PassedCount:    s.PassedCount,
+		FailedCount:    s.FailedCount,
+		CancelledCount: s.CancelledCount,
+		TotalSteps:     s.TotalSteps,
+		PassedSteps:    s.PassedSteps,
+		FailedSteps:    s.FailedSteps,
+		TotalDuration:  s.TotalDuration.Round(time.Millisecond).String(),
+		Text:           s.Text,
+		Scenarios:      make([]scenarioReportJSON, 0, len(s.Results)),
+	}
+
+	for _, r := range s.Results {
+		scenario := scenarioReportJSON{
+			Scenario:    r.Scenario,
+			Success:     r.Success,
+			Cancelled:   r.Cancelled,
+			TotalSteps:  r.TotalSteps,
+			PassedSteps: r.PassedSteps,
+			FailedSteps: r.FailedSteps,
+			Duration:    r.Duration.Round(time.Millisecond).String(),
+			Error:       errorString(r.Error),
+			Steps:       make([]stepReportJSON, 0, len(r.Steps)),
+		}
+		for _, step := range r.Steps {
+			scenario.Steps = append(scenario.Steps, stepReportJSON{
+				StepNumber:  step.StepNumber,
+				Action:      step.Action,
+				Description: step.Description,
+				Status:      string(step.Status),
+				Duration:    step.Duration.Round(time.Millisecond).String(),
+				Error:       errorString(step.Error),
+			})
+		}
+		report.Scenarios = append(report.Scenarios, scenario)
+	}
+
+	return json.MarshalIndent(report, "", "  ")
+}
+
+// formatMarkdown renders the summary as a Markdown document
+func (s *Summary) formatMarkdown() []byte {
+	var buf bytes.Buffer
+
+	result := "ALL PASSED"
+	if s.FailedCount > 0 {
+		result = "SOME FAILED"
+	} else if s.CancelledCount > 0 {
+		result = "CANCELLED"
+	}
+
+	fmt.Fprintln(&buf, "# Test Summary")
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "**Result**: %s\n\n", result)
+	if s.CancelledCount > 0 {
+		fmt.Fprintf(&buf, "- Scenarios: %d passed, %d failed, %d cancelled, %d total\n", s.PassedCount, s.FailedCount, s.CancelledCount, s.TotalScenarios)
+	} else {
+		fmt.Fprintf(&buf, "- Scenarios: %d passed, %d failed, %d total\n", s.PassedCount, s.FailedCount, s.TotalScenarios)
+	}
+	fmt.Fprintf(&buf, "- Steps: %d passed, %d failed, %d total\n", s.PassedSteps, s.FailedSteps, s.TotalSteps)
+	fmt.Fprintf(&buf, "- Duration: %v\n", s.TotalDuration.Round(time.Millisecond))
+
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "## Scenarios")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "| Status | Scenario | Steps | Duration |")
+	fmt.Fprintln(&buf, "|--------|----------|-------|----------|")
+	for _, r := range s.Results {
+		status := "PASS"
+		if r.Cancelled {
+			status = "CANCELLED"
+		} else if !r.Success {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&buf, "| %s | %s | %d/%d | %v |\n",
+			status, escapeMarkdownCell(r.Scenario), r.PassedSteps, r.TotalSteps, r.Duration.Round(time.Millisecond))
+	}
+
+	if s.FailedCount > 0 {
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, "## Failures")
+		for _, r := range s.Results {
+			if r.Success || r.Cancelled {
+				continue
+			}
+			fmt.Fprintln(&buf)
+			fmt.Fprintf(&buf, "### %s\n\n", r.Scenario)
+			for _, step := range r.Steps {
+				if step.Status != StepStatusFailed {
+					continue
+				}
+				fmt.Fprintf(&buf, "- Step %d (`%s`): %s\n", step.StepNumber, step.Action, step.Description)
+				if step.Error != nil {
+					fmt.Fprintf(&buf, "  - Error: `%v`\n", step.Error)
+				}
+			}
+			if len(r.Steps) == 0 && r.Error != nil {
+				fmt.Fprintf(&buf, "- Error: `%v`\n", r.Error)
+			}
+		}
+	}
+
+	if s.Text != "" {
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, "## Analysis")
+		fmt.Fprintln(&buf)
+		fmt.Fprintln(&buf, s.Text)
+	}
+
+	return buf.Bytes()
+}
+
+// errorString returns the error message or an empty string for nil errors
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// escapeMarkdownCell escapes characters that would break a Markdown table cell
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -47,43 +47,12 @@ func (p *AnthropicProvider) ParseScenario(ctx context.Context, scenarioText stri
 		return nil, fmt.Errorf("failed to build user prompt: %w", err)
 	}
 
-	temperature := float32(p.temperature)
-	resp, err := p.client.CreateMessages(ctx, anthropic.MessagesRequest{
-		Model:  anthropic.Model(p.model),
-		System: systemPrompt,
-		Messages: []anthropic.Message{
-			{
-				Role: anthropic.RoleUser,
-				Content: []anthropic.MessageContent{
-					anthropic.NewTextMessageContent(userPrompt),
-				},
-			},
-		},
-		Temperature: &temperature,
-		MaxTokens:   p.maxTokens,
-	})
+	content, err := p.complete(ctx, systemPrompt, userPrompt)
 	if err != nil {
-		return nil, fmt.Errorf("Anthropic API error: %w", err)
-	}
-
-	if len(resp.Content) == 0 {
-		return nil, fmt.Errorf("no response from Anthropic")
+		return nil, err
 	}
 
-	// Extract text content from the response
-	var content string
-	for _, block := range resp.Content {
-		if block.Type == "text" && block.Text != nil {
-			content = *block.Text
-			break
-		}
-	}
-
-	if content == "" {
-		return nil, fmt.Errorf("no text content in Anthropic response")
-	}
-
-	steps, err := ExtractJSONFromResponse(content)
+	steps, err := extractStepsWithReformat(ctx, p.complete, systemPrompt, content)
 	if err != nil {
 		return &ParseResponse{
 			Error: fmt.Sprintf("failed to parse LLM response: %v\nResponse: %s", err, content),
@@ -96,11 +65,31 @@ func (p *AnthropicProvider) ParseScenario(ctx context.Context, scenarioText stri
 }
 
 // ValidateStep implements Provider.ValidateStep
+// For failed steps, the model is asked to suggest a corrected step
 func (p *AnthropicProvider) ValidateStep(ctx context.Context, step *StepResult, sctx *ScenarioContext) (*ValidationResponse, error) {
-	return &ValidationResponse{
-		Valid:   step.Status == "passed",
-		Message: fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
-	}, nil
+	if step.Status == "passed" {
+		return &ValidationResponse{
+			Valid:   true,
+			Message: fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
+		}, nil
+	}
+
+	systemPrompt, err := BuildSystemPrompt(sctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build system prompt: %w", err)
+	}
+
+	correctionPrompt, err := BuildCorrectionPrompt(step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build correction prompt: %w", err)
+	}
+
+	content, err := p.complete(ctx, systemPrompt, correctionPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCorrectionResponse(step, content), nil
 }
 
 // GenerateSummary implements Provider.GenerateSummary
@@ -138,6 +127,40 @@ func (p *AnthropicProvider) GenerateSummary(ctx context.Context, results *Summar
 	return "", fmt.Errorf("no text content in response")
 }
 
+// complete sends a single-turn request and returns the first text block of the response
+func (p *AnthropicProvider) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	temperature := float32(p.temperature)
+	resp, err := p.client.CreateMessages(ctx, anthropic.MessagesRequest{
+		Model:  anthropic.Model(p.model),
+		System: systemPrompt,
+		Messages: []anthropic.Message{
+			{
+				Role: anthropic.RoleUser,
+				Content: []anthropic.MessageContent{
+					anthropic.NewTextMessageContent(userPrompt),
+				},
+			},
+		},
+		Temperature: &temperature,
+		MaxTokens:   p.maxTokens,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Anthropic API error: %w", err)
+	}
+
+	if len(resp.Content) == 0 {
+		return "", fmt.Errorf("no response from Anthropic")
+	}
+
+	for _, block := range resp.Content {
+		if block.Type == "text" && block.Text != nil {
+			return *block.Text, nil
+		}
+	}
+
+	return "", fmt.Errorf("no text content in Anthropic response")
+}
+
 // Close implements Provider.Close
 func (p *AnthropicProvider) Close() error {
 	// Anthropic client doesn't need explicit cleanup
@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SplitScenarioSections splits scenario text into chunks of blank-line-separated sections
+// Adjacent sections are grouped while the chunk stays within maxChars, so short headings
+// stay together with the steps that follow them. A single section larger than maxChars
+// becomes its own chunk.
+func SplitScenarioSections(scenarioText string, maxChars int) []string {
+	normalized := strings.ReplaceAll(scenarioText, "\r\n", "\n")
+
+	var sections []string
+	var current []string
+	for _, line := range strings.Split(normalized, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				sections = append(sections, strings.Join(current, "\n"))
+				current = nil
+			}
+			continue
+		}
+		current = append(current, line)
+	}
+	if len(current) > 0 {
+		sections = append(sections, strings.Join(current, "\n"))
+	}
+
+	var chunks []string
+	var chunk string
+	for _, section := range sections {
+		if chunk == "" {
+			chunk = section
+			continue
+		}
+		if len(chunk)+len(section)+2 > maxChars {
+			chunks = append(chunks, chunk)
+			chunk = section
+			continue
+		}
+		chunk += "\n\n" + section
+	}
+	if chunk != "" {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// ParseScenarioInChunks parses a long scenario section by section and concatenates the steps
+// This keeps each response well within MaxTokens for long end-to-end scenarios.
+// Leading "connect" steps of later chunks are dropped while the scenario is still connected
+// (its last connect/disconnect step so far was a connect), since each chunk is parsed
+// without knowledge of the previous ones. A reconnect after a disconnect is kept.
+func ParseScenarioInChunks(ctx context.Context, p Provider, scenarioText string, sctx *ScenarioContext, maxChars int) (*ParseResponse, error) {
+	chunks := SplitScenarioSections(scenarioText, maxChars)
+	if len(chunks) <= 1 {
+		return p.ParseScenario(ctx, scenarioText, sctx)
+	}
+
+	var steps []ScenarioStep
+	connected := false
+	for i, chunk := range chunks {
+		resp, err := p.ParseScenario(ctx, chunk, sctx)
+		if err != nil {
+			return nil, fmt.Errorf("chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+		if resp.Error != "" {
+			return &ParseResponse{
+				Error: fmt.Sprintf("chunk %d/%d: %s", i+1, len(chunks), resp.Error),
+			}, nil
+		}
+
+		chunkSteps := resp.Steps
+		if connected {
+			for len(chunkSteps) > 0 && chunkSteps[0].Action == "connect" {
+				chunkSteps = chunkSteps[1:]
+			}
+		}
+		for _, step := range chunkSteps {
+			switch step.Action {
+			case "connect":
+				connected = true
+			case "disconnect":
+				connected = false
+			}
+		}
+
+		steps = append(steps, chunkSteps...)
+	}
+
+	return &ParseResponse{
+		Steps: steps,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package llm
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitScenarioSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxChars int
+		want     []string
+	}{
+		{"empty", "", 100, nil},
+		{"single section", "connect\nsay hi", 100, []string{"connect\nsay hi"}},
+		{"sections grouped", "a\n\nb\n\nc", 100, []string{"a\n\nb\n\nc"}},
+		{"sections split", "aaaa\n\nbbbb\n\ncccc", 10, []string{"aaaa\n\nbbbb", "cccc"}},
+		{"oversized section", "aaaaaaaaaaaa\n\nb", 5, []string{"aaaaaaaaaaaa", "b"}},
+		{"crlf and blank runs", "a\r\n\r\n\r\nb", 3, []string{"a", "b"}},
+		{"whitespace lines separate", "a\n   \nb", 100, []string{"a\n\nb"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitScenarioSections(tt.text, tt.maxChars); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitScenarioSections = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// lineProvider parses every line of the scenario text as the action of one step
+type lineProvider struct{}
+
+func (lineProvider) ParseScenario(ctx context.Context, scenarioText string, sctx *ScenarioContext) (*ParseResponse, error) {
+	var steps []ScenarioStep
+	for _, line := range strings.Split(scenarioText, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			steps = append(steps, ScenarioStep{Action: line})
+		}
+	}
+	return &ParseResponse{Steps: steps}, nil
+}
+
+func (lineProvider) ValidateStep(ctx context.Context, step *StepResult, sctx *ScenarioContext) (*ValidationResponse, error) {
+	return nil, nil
+}
+
+func (lineProvider) GenerateSummary(ctx context.Context, results *SummaryInput) (string, error) {
+	return "", nil
+}
+
+func (lineProvider) Close() error { return nil }
+
+func TestParseScenarioInChunksConnect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"repeated connect dropped", "connect\nchat\n\nconnect\nwait", []string{"connect", "chat", "wait"}},
+		{"reconnect after disconnect kept", "connect\ndisconnect\n\nconnect\nchat", []string{"connect", "disconnect", "connect", "chat"}},
+		{"disconnect in its own chunk", "connect\n\ndisconnect\n\nconnect", []string{"connect", "disconnect", "connect"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := ParseScenarioInChunks(context.Background(), lineProvider{}, tt.text, nil, 1)
+			if err != nil {
+				t.Fatalf("ParseScenarioInChunks error = %v", err)
+			}
+			var got []string
+			for _, step := range resp.Steps {
+				got = append(got, step.Action)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("actions = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
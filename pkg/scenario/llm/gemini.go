@@ -0,0 +1,227 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gollilla/best/pkg/config"
+)
+
+// geminiBaseURL is the endpoint of Google's Generative Language API
+const geminiBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// GeminiProvider implements the Provider interface using Google's Gemini API
+type GeminiProvider struct {
+	BaseProvider
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewGeminiProvider creates a new Gemini provider
+// The API key is read from GEMINI_API_KEY when apiKey is not set in the config.
+func NewGeminiProvider(cfg *config.AIConfig) (*GeminiProvider, error) {
+	apiKey := os.ExpandEnv(cfg.APIKey)
+	if apiKey == "" {
+		apiKey = os.Getenv("GEMINI_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("Gemini API key is required (set apiKey in config or GEMINI_API_KEY environment variable)")
+	}
+
+	base := newBaseProvider(cfg)
+	if base.model == "" {
+		base.model = "gemini-1.5-flash"
+	}
+
+	httpClient := &http.Client{}
+	if cfg.Timeout > 0 {
+		httpClient.Timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+
+	return &GeminiProvider{
+		BaseProvider: base,
+		apiKey:       apiKey,
+		httpClient:   httpClient,
+	}, nil
+}
+
+// ParseScenario implements Provider.ParseScenario
+func (p *GeminiProvider) ParseScenario(ctx context.Context, scenarioText string, sctx *ScenarioContext) (*ParseResponse, error) {
+	systemPrompt, err := BuildSystemPrompt(sctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build system prompt: %w", err)
+	}
+
+	userPrompt, err := BuildUserPrompt(scenarioText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build user prompt: %w", err)
+	}
+
+	content, err := p.complete(ctx, systemPrompt, userPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := extractStepsWithReformat(ctx, p.complete, systemPrompt, content)
+	if err != nil {
+		return &ParseResponse{
+			Error: fmt.Sprintf("failed to parse LLM response: %v\nResponse: %s", err, content),
+		}, nil
+	}
+
+	return &ParseResponse{
+		Steps: steps,
+	}, nil
+}
+
+// ValidateStep implements Provider.ValidateStep
+// For failed steps, the model is asked to suggest a corrected step
+func (p *GeminiProvider) ValidateStep(ctx context.Context, step *StepResult, sctx *ScenarioContext) (*ValidationResponse, error) {
+	if step.Status == "passed" {
+		return &ValidationResponse{
+			Valid:   true,
+			Message: fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
+		}, nil
+	}
+
+	systemPrompt, err := BuildSystemPrompt(sctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build system prompt: %w", err)
+	}
+
+	correctionPrompt, err := BuildCorrectionPrompt(step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build correction prompt: %w", err)
+	}
+
+	content, err := p.complete(ctx, systemPrompt, correctionPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCorrectionResponse(step, content), nil
+}
+
+// GenerateSummary implements Provider.GenerateSummary
+func (p *GeminiProvider) GenerateSummary(ctx context.Context, results *SummaryInput) (string, error) {
+	return p.complete(ctx, "", BuildSummaryPrompt(results))
+}
+
+// Request and response bodies of the generateContent endpoint
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiGenerationConfig struct {
+	Temperature     float64 `json:"temperature"`
+	MaxOutputTokens int     `json:"maxOutputTokens"`
+}
+
+type geminiRequest struct {
+	SystemInstruction *geminiContent         `json:"systemInstruction,omitempty"`
+	Contents          []geminiContent        `json:"contents"`
+	GenerationConfig  geminiGenerationConfig `json:"generationConfig"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content      geminiContent `json:"content"`
+		FinishReason string        `json:"finishReason"`
+	} `json:"candidates"`
+	PromptFeedback struct {
+		BlockReason string `json:"blockReason"`
+	} `json:"promptFeedback"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	} `json:"error"`
+}
+
+// complete sends a system/user prompt pair and returns the text of the first candidate
+// An empty system prompt is left out of the request.
+func (p *GeminiProvider) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	reqBody := geminiRequest{
+		Contents: []geminiContent{
+			{Role: "user", Parts: []geminiPart{{Text: userPrompt}}},
+		},
+		GenerationConfig: geminiGenerationConfig{
+			Temperature:     p.temperature,
+			MaxOutputTokens: p.maxTokens,
+		},
+	}
+	if systemPrompt != "" {
+		reqBody.SystemInstruction = &geminiContent{Parts: []geminiPart{{Text: systemPrompt}}}
+	}
+
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Gemini request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/models/%s:generateContent", geminiBaseURL, url.PathEscape(p.model))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create Gemini request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-goog-api-key", p.apiKey)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Gemini API error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Gemini response: %w", err)
+	}
+
+	var result geminiResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", fmt.Errorf("Gemini API error: status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if result.Error != nil {
+		return "", fmt.Errorf("Gemini API error: %s (%s)", result.Error.Message, result.Error.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Gemini API error: status %d", resp.StatusCode)
+	}
+
+	if len(result.Candidates) == 0 {
+		if result.PromptFeedback.BlockReason != "" {
+			return "", fmt.Errorf("Gemini blocked the prompt: %s", result.PromptFeedback.BlockReason)
+		}
+		return "", fmt.Errorf("no response from Gemini")
+	}
+
+	var text strings.Builder
+	for _, part := range result.Candidates[0].Content.Parts {
+		text.WriteString(part.Text)
+	}
+	if text.Len() == 0 {
+		return "", fmt.Errorf("no text content in Gemini response (finish reason: %s)", result.Candidates[0].FinishReason)
+	}
+
+	return text.String(), nil
+}
+
+// Close implements Provider.Close
+func (p *GeminiProvider) Close() error {
+	p.httpClient.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,212 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gollilla/best/pkg/config"
+)
+
+// DefaultOllamaBaseURL is the address of a local Ollama server
+const DefaultOllamaBaseURL = "http://localhost:11434"
+
+// OllamaProvider implements the Provider interface using a local Ollama server
+// Scenario text never leaves the machine, so it can be used where cloud APIs are not allowed.
+type OllamaProvider struct {
+	BaseProvider
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewOllamaProvider creates a new Ollama provider
+// The server address is taken from baseURL in the config (default http://localhost:11434).
+func NewOllamaProvider(cfg *config.AIConfig) (*OllamaProvider, error) {
+	baseURL := strings.TrimRight(cfg.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = DefaultOllamaBaseURL
+	}
+
+	base := newBaseProvider(cfg)
+	if base.model == "" {
+		base.model = "llama3"
+	}
+
+	httpClient := &http.Client{}
+	if cfg.Timeout > 0 {
+		httpClient.Timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+
+	return &OllamaProvider{
+		BaseProvider: base,
+		baseURL:      baseURL,
+		httpClient:   httpClient,
+	}, nil
+}
+
+// ParseScenario implements Provider.ParseScenario
+func (p *OllamaProvider) ParseScenario(ctx context.Context, scenarioText string, sctx *ScenarioContext) (*ParseResponse, error) {
+	systemPrompt, err := BuildSystemPrompt(sctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build system prompt: %w", err)
+	}
+
+	userPrompt, err := BuildUserPrompt(scenarioText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build user prompt: %w", err)
+	}
+
+	content, err := p.complete(ctx, systemPrompt, userPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := extractStepsWithReformat(ctx, p.complete, systemPrompt, content)
+	if err != nil {
+		return &ParseResponse{
+			Error: fmt.Sprintf("failed to parse LLM response: %v\nResponse: %s", err, content),
+		}, nil
+	}
+
+	return &ParseResponse{
+		Steps: steps,
+	}, nil
+}
+
+// ValidateStep implements Provider.ValidateStep
+// For failed steps, the model is asked to suggest a corrected step
+func (p *OllamaProvider) ValidateStep(ctx context.Context, step *StepResult, sctx *ScenarioContext) (*ValidationResponse, error) {
+	if step.Status == "passed" {
+		return &ValidationResponse{
+			Valid:   true,
+			Message: fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
+		}, nil
+	}
+
+	systemPrompt, err := BuildSystemPrompt(sctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build system prompt: %w", err)
+	}
+
+	correctionPrompt, err := BuildCorrectionPrompt(step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build correction prompt: %w", err)
+	}
+
+	content, err := p.complete(ctx, systemPrompt, correctionPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCorrectionResponse(step, content), nil
+}
+
+// GenerateSummary implements Provider.GenerateSummary
+func (p *OllamaProvider) GenerateSummary(ctx context.Context, results *SummaryInput) (string, error) {
+	return p.complete(ctx, "", BuildSummaryPrompt(results))
+}
+
+// Request and response bodies of the /api/chat endpoint
+type ollamaMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type ollamaOptions struct {
+	Temperature float64 `json:"temperature"`
+	NumPredict  int     `json:"num_predict"`
+}
+
+type ollamaChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []ollamaMessage `json:"messages"`
+	Stream   bool            `json:"stream"`
+	Options  ollamaOptions   `json:"options"`
+}
+
+type ollamaChatResponse struct {
+	Message ollamaMessage `json:"message"`
+	Done    bool          `json:"done"`
+	Error   string        `json:"error"`
+}
+
+// complete sends a system/user prompt pair and returns the assistant's reply
+// Streaming is disabled in the request, but servers and proxies that stream anyway answer with
+// one JSON object per line; their message chunks are joined.
+func (p *OllamaProvider) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	messages := make([]ollamaMessage, 0, 2)
+	if systemPrompt != "" {
+		messages = append(messages, ollamaMessage{Role: "system", Content: systemPrompt})
+	}
+	messages = append(messages, ollamaMessage{Role: "user", Content: userPrompt})
+
+	body, err := json.Marshal(ollamaChatRequest{
+		Model:    p.model,
+		Messages: messages,
+		Stream:   false,
+		Options: ollamaOptions{
+			Temperature: p.temperature,
+			NumPredict:  p.maxTokens,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Ollama request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/api/chat", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create Ollama request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Ollama API error (is Ollama running at %s?): %w", p.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	var content strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var chunk ollamaChatResponse
+		if err := json.Unmarshal(line, &chunk); err != nil {
+			return "", fmt.Errorf("Ollama API error: status %d: %s", resp.StatusCode, line)
+		}
+		if chunk.Error != "" {
+			return "", fmt.Errorf("Ollama API error: %s", chunk.Error)
+		}
+		content.WriteString(chunk.Message.Content)
+		if chunk.Done {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read Ollama response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Ollama API error: status %d", resp.StatusCode)
+	}
+	if content.Len() == 0 {
+		return "", fmt.Errorf("no response from Ollama")
+	}
+
+	return content.String(), nil
+}
+
+// Close implements Provider.Close
+func (p *OllamaProvider) Close() error {
+	p.httpClient.CloseIdleConnections()
+	return nil
+}
@@ -47,32 +47,12 @@ func (p *OpenAIProvider) ParseScenario(ctx context.Context, scenarioText string,
 		return nil, fmt.Errorf("failed to build user prompt: %w", err)
 	}
 
-	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
-		Model: p.model,
-		Messages: []openai.ChatCompletionMessage{
-			{
-				Role:    openai.ChatMessageRoleSystem,
-				Content: systemPrompt,
-			},
-			{
-				Role:    openai.ChatMessageRoleUser,
-				Content: userPrompt,
-			},
-		},
-		Temperature: float32(p.temperature),
-		MaxTokens:   p.maxTokens,
-	})
+	content, err := p.complete(ctx, systemPrompt, userPrompt)
 	if err != nil {
-		return nil, fmt.Errorf("OpenAI API error: %w", err)
+		return nil, err
 	}
 
-	if len(resp.Choices) == 0 {
-		return nil, fmt.Errorf("no response from OpenAI")
-	}
-
-	content := resp.Choices[0].Message.Content
-
-	steps, err := ExtractJSONFromResponse(content)
+	steps, err := extractStepsWithReformat(ctx, p.complete, systemPrompt, content)
 	if err != nil {
 		return &ParseResponse{
 			Error: fmt.Sprintf("failed to parse LLM response: %v\nResponse: %s", err, content),
@@ -85,11 +65,31 @@ func (p *OpenAIProvider) ParseScenario(ctx context.Context, scenarioText string,
 }
 
 // ValidateStep implements Provider.ValidateStep
+// For failed steps, the model is asked to suggest a corrected step
 func (p *OpenAIProvider) ValidateStep(ctx context.Context, step *StepResult, sctx *ScenarioContext) (*ValidationResponse, error) {
-	return &ValidationResponse{
-		Valid:   step.Status == "passed",
-		Message: fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
-	}, nil
+	if step.Status == "passed" {
+		return &ValidationResponse{
+			Valid:   true,
+			Message: fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
+		}, nil
+	}
+
+	systemPrompt, err := BuildSystemPrompt(sctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build system prompt: %w", err)
+	}
+
+	correctionPrompt, err := BuildCorrectionPrompt(step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build correction prompt: %w", err)
+	}
+
+	content, err := p.complete(ctx, systemPrompt, correctionPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCorrectionResponse(step, content), nil
 }
 
 // GenerateSummary implements Provider.GenerateSummary
@@ -118,6 +118,34 @@ func (p *OpenAIProvider) GenerateSummary(ctx context.Context, results *SummaryIn
 	return resp.Choices[0].Message.Content, nil
 }
 
+// complete sends a system/user prompt pair and returns the first choice's content
+func (p *OpenAIProvider) complete(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: p.model,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role:    openai.ChatMessageRoleSystem,
+				Content: systemPrompt,
+			},
+			{
+				Role:    openai.ChatMessageRoleUser,
+				Content: userPrompt,
+			},
+		},
+		Temperature: float32(p.temperature),
+		MaxTokens:   p.maxTokens,
+	})
+	if err != nil {
+		return "", fmt.Errorf("OpenAI API error: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("no response from OpenAI")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
+
 // Close implements Provider.Close
 func (p *OpenAIProvider) Close() error {
 	// OpenAI client doesn't need explicit cleanup
@@ -3,6 +3,8 @@ package llm
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"text/template"
 )
 
@@ -52,6 +54,8 @@ const systemPromptTemplate = `あなたはMinecraft Bedrock Editionサーバー
 - 待機時間（duration）は "2s", "500ms", "1m" などの形式で指定してください
 - シナリオの意図を正確に理解し、適切なステップに変換してください
 - 接続が必要な場合は最初にconnectアクションを含めてください
+- 複数のプレイヤーが登場する場合は connect_agent で名前付きエージェントを接続し、そのエージェントで行うステップの params に "agent": "名前" を指定してください（省略時はメインのエージェント）
+- 実行時にしか分からない値（フォームのボタン名など）は read_form で読み取り、後続ステップのパラメータで "${form.button.0}" のように参照してください
 `
 
 const userPromptTemplate = `以下のシナリオを実行可能なステップに変換してください：
@@ -59,6 +63,31 @@ const userPromptTemplate = `以下のシナリオを実行可能なステップ
 {{.ScenarioText}}
 `
 
+const correctionPromptTemplate = `以下のステップの実行に失敗しました。
+
+ステップ {{.StepNumber}}: {{.Description}}
+アクション: {{.Action}}
+パラメータ: {{.ParamsJSON}}
+エラー: {{.Error}}
+
+エラー内容を踏まえ、同じ意図を達成する修正済みのステップを1つだけ、以下のJSON形式で出力してください。必ずJSONのみを出力し、他のテキストは含めないでください。
+
+{
+  "action": "アクション名またはアサーション名",
+  "description": "ステップの説明（日本語）",
+  "params": {
+    "パラメータ名": "値"
+  }
+}
+`
+
+const reformatPromptTemplate = `以下の応答はJSONとして解析できませんでした（エラー: {{.Error}}）。
+
+{{.Response}}
+
+内容を変えずに、指定されたJSON形式（"steps" 配列を持つオブジェクト）に整形し直してください。必ずJSONのみを出力し、他のテキストは含めないでください。
+`
+
 type promptData struct {
 	Actions    []ActionDefinition
 	Assertions []AssertionDefinition
@@ -107,6 +136,61 @@ func BuildUserPrompt(scenarioText string) (string, error) {
 	return buf.String(), nil
 }
 
+type correctionPromptData struct {
+	*StepResult
+	ParamsJSON string
+}
+
+// BuildCorrectionPrompt builds a prompt asking for a corrected version of a failed step
+func BuildCorrectionPrompt(step *StepResult) (string, error) {
+	tmpl, err := template.New("correction").Parse(correctionPromptTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	paramsJSON, err := json.Marshal(step.Params)
+	if err != nil {
+		return "", err
+	}
+
+	data := correctionPromptData{
+		StepResult: step,
+		ParamsJSON: string(paramsJSON),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+type reformatPromptData struct {
+	Response string
+	Error    string
+}
+
+// BuildReformatPrompt builds a prompt asking the model to reformat an unparsable response as JSON
+func BuildReformatPrompt(response string, parseErr error) (string, error) {
+	tmpl, err := template.New("reformat").Parse(reformatPromptTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	data := reformatPromptData{
+		Response: response,
+		Error:    parseErr.Error(),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
 // BuildSummaryPrompt builds a prompt for generating a test summary
 func BuildSummaryPrompt(results *SummaryInput) string {
 	jsonData, _ := json.MarshalIndent(results, "", "  ")
@@ -118,25 +202,23 @@ func BuildSummaryPrompt(results *SummaryInput) string {
 }
 
 // ExtractJSONFromResponse extracts JSON from the LLM response
-// It handles cases where the response might contain markdown code blocks
+// It handles cases where the response might contain markdown code blocks or surrounding prose
 func ExtractJSONFromResponse(response string) ([]ScenarioStep, error) {
-	// Try to find JSON in code blocks first
-	jsonStr := response
+	steps, err := parseStepsJSON(stripCodeBlock(response))
+	if err == nil {
+		return steps, nil
+	}
 
-	// Remove markdown code blocks if present
-	if start := bytes.Index([]byte(response), []byte("```json")); start != -1 {
-		end := bytes.Index([]byte(response[start+7:]), []byte("```"))
-		if end != -1 {
-			jsonStr = response[start+7 : start+7+end]
-		}
-	} else if start := bytes.Index([]byte(response), []byte("```")); start != -1 {
-		end := bytes.Index([]byte(response[start+3:]), []byte("```"))
-		if end != -1 {
-			jsonStr = response[start+3 : start+3+end]
-		}
+	// Fall back to the outermost balanced JSON value in the response that holds steps
+	if jsonStr := extractBalancedJSON(response, isStepsJSON); jsonStr != "" {
+		return parseStepsJSON(jsonStr)
 	}
 
-	// Parse the JSON
+	return nil, err
+}
+
+// parseStepsJSON parses either a {"steps": [...]} object or a bare step array
+func parseStepsJSON(jsonStr string) ([]ScenarioStep, error) {
 	var result ParseResponse
 	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
 		// Try parsing as an array directly
@@ -149,3 +231,138 @@ func ExtractJSONFromResponse(response string) ([]ScenarioStep, error) {
 
 	return result.Steps, nil
 }
+
+// isStepsJSON reports whether jsonStr parses into at least one step, each with an action
+func isStepsJSON(jsonStr string) bool {
+	steps, err := parseStepsJSON(jsonStr)
+	if err != nil || len(steps) == 0 {
+		return false
+	}
+	for _, step := range steps {
+		if step.Action == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isStepJSON reports whether jsonStr parses into a single step with an action
+func isStepJSON(jsonStr string) bool {
+	var step ScenarioStep
+	return json.Unmarshal([]byte(jsonStr), &step) == nil && step.Action != ""
+}
+
+// ExtractStepFromResponse extracts a single step from the LLM response
+func ExtractStepFromResponse(response string) (*ScenarioStep, error) {
+	var step ScenarioStep
+	if err := json.Unmarshal([]byte(stripCodeBlock(response)), &step); err != nil {
+		jsonStr := extractBalancedJSON(response, isStepJSON)
+		if jsonStr == "" || json.Unmarshal([]byte(jsonStr), &step) != nil {
+			return nil, err
+		}
+	}
+	if step.Action == "" {
+		return nil, fmt.Errorf("corrected step has no action")
+	}
+	return &step, nil
+}
+
+// newCorrectionResponse builds a ValidationResponse for a failed step from the model's correction
+func newCorrectionResponse(step *StepResult, content string) *ValidationResponse {
+	corrected, err := ExtractStepFromResponse(content)
+	if err != nil {
+		return &ValidationResponse{
+			Valid: false,
+			Error: fmt.Sprintf("failed to parse corrected step: %v\nResponse: %s", err, content),
+		}
+	}
+
+	return &ValidationResponse{
+		Valid:         false,
+		Message:       fmt.Sprintf("Step %d: %s", step.StepNumber, step.Status),
+		CorrectedStep: corrected,
+	}
+}
+
+// stripCodeBlock returns the contents of the first markdown code block, or the response as-is
+func stripCodeBlock(response string) string {
+	if start := strings.Index(response, "```json"); start != -1 {
+		end := strings.Index(response[start+7:], "```")
+		if end != -1 {
+			return response[start+7 : start+7+end]
+		}
+	} else if start := strings.Index(response, "```"); start != -1 {
+		end := strings.Index(response[start+3:], "```")
+		if end != -1 {
+			return response[start+3 : start+3+end]
+		}
+	}
+	return response
+}
+
+// extractBalancedJSON returns the longest outermost balanced {...} or [...] value in s accepted by accept,
+// ignoring brackets inside strings
+// Values nested in an accepted one are skipped, so an example object inside the answer never wins
+// over the answer itself, while a value nested in a rejected one (e.g. a step array inside an
+// unrelated wrapper) is still found. Among separate accepted values the longest is returned, so a
+// short example before the real answer is passed over; ties go to the earlier value. It returns an
+// empty string if no candidate is accepted.
+func extractBalancedJSON(s string, accept func(candidate string) bool) string {
+	best := ""
+	start := strings.IndexAny(s, "{[")
+	for start != -1 {
+		next := start + 1
+		if end := matchBracket(s, start); end != -1 && accept(s[start:end+1]) {
+			if end+1-start > len(best) {
+				best = s[start : end+1]
+			}
+			next = end + 1
+		}
+		offset := strings.IndexAny(s[next:], "{[")
+		if offset == -1 {
+			break
+		}
+		start = next + offset
+	}
+	return best
+}
+
+// matchBracket returns the index of the bracket closing the one at s[start], or -1
+func matchBracket(s string, start int) int {
+	var stack []byte
+	inString := false
+	escaped := false
+
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{':
+			stack = append(stack, '}')
+		case '[':
+			stack = append(stack, ']')
+		case '}', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return -1
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,71 @@
+package llm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExtractBalancedJSON(t *testing.T) {
+	valid := func(candidate string) bool { return json.Valid([]byte(candidate)) }
+
+	tests := []struct {
+		name   string
+		in     string
+		accept func(string) bool
+		want   string
+	}{
+		{"object", `{"steps": []}`, valid, `{"steps": []}`},
+		{"surrounding prose", `Here you go: {"a": 1} done`, valid, `{"a": 1}`},
+		{"array", `result [1, [2, 3]] end`, valid, `[1, [2, 3]]`},
+		{"brackets in strings", `{"text": "a } b ]"}`, valid, `{"text": "a } b ]"}`},
+		{"escaped quote", `{"text": "say \"}\""}`, valid, `{"text": "say \"}\""}`},
+		{"unbalanced prefix", `{ oops [1, 2]`, valid, `[1, 2]`},
+		{"invalid candidate skipped", `use {placeholders} like this: {"a": 1}`, valid, `{"a": 1}`},
+		{"schema candidate preferred", `{"note": "x"} then {"steps": [{"action": "wait"}]}`, isStepsJSON, `{"steps": [{"action": "wait"}]}`},
+		{"nested candidate", `{"result": [{"action": "wait"}]}`, isStepsJSON, `[{"action": "wait"}]`},
+		{"outermost over nested", `{"steps": [{"action": "chat", "params": {"example": [{"action": "wait"}]}}]}`, isStepsJSON, `{"steps": [{"action": "chat", "params": {"example": [{"action": "wait"}]}}]}`},
+		{"longest over earlier example", `e.g. [{"action": "wait"}] so: [{"action": "connect"}, {"action": "chat"}]`, isStepsJSON, `[{"action": "connect"}, {"action": "chat"}]`},
+		{"none", `no json here`, valid, ``},
+		{"unterminated", `{"a": 1`, valid, ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractBalancedJSON(tt.in, tt.accept); got != tt.want {
+				t.Errorf("extractBalancedJSON(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractJSONFromResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		actions  []string
+		wantErr  bool
+	}{
+		{"bare object", `{"steps": [{"action": "connect"}]}`, []string{"connect"}, false},
+		{"code block", "```json\n[{\"action\": \"chat\"}]\n```", []string{"chat"}, false},
+		{"example before answer", `For example {"note": "ignored"}. Answer: {"steps": [{"action": "wait"}, {"action": "disconnect"}]}`, []string{"wait", "disconnect"}, false},
+		{"example step before answer", `A step looks like [{"action": "wait"}]. Your scenario: [{"action": "connect"}, {"action": "chat", "params": {"message": "hi"}}, {"action": "disconnect"}]`, []string{"connect", "chat", "disconnect"}, false},
+		{"no steps", `I cannot parse this`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := ExtractJSONFromResponse(tt.response)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractJSONFromResponse error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(steps) != len(tt.actions) {
+				t.Fatalf("got %d steps, want %d", len(steps), len(tt.actions))
+			}
+			for i, step := range steps {
+				if step.Action != tt.actions[i] {
+					t.Errorf("step %d action = %q, want %q", i, step.Action, tt.actions[i])
+				}
+			}
+		})
+	}
+}
@@ -33,8 +33,12 @@ func NewProvider(cfg *config.AIConfig) (Provider, error) {
 		return NewOpenAIProvider(cfg)
 	case "anthropic":
 		return NewAnthropicProvider(cfg)
+	case "gemini":
+		return NewGeminiProvider(cfg)
+	case "ollama":
+		return NewOllamaProvider(cfg)
 	default:
-		return nil, fmt.Errorf("unsupported LLM provider: %s (supported: openai, anthropic)", cfg.Provider)
+		return nil, fmt.Errorf("unsupported LLM provider: %s (supported: openai, anthropic, gemini, ollama)", cfg.Provider)
 	}
 }
 
@@ -63,3 +67,30 @@ func newBaseProvider(cfg *config.AIConfig) BaseProvider {
 		maxTokens:   maxTokens,
 	}
 }
+
+// completeFunc sends a system/user prompt pair to a provider and returns the text response
+type completeFunc func(ctx context.Context, systemPrompt, userPrompt string) (string, error)
+
+// extractStepsWithReformat extracts steps from a response, asking the model to reformat it once on failure
+func extractStepsWithReformat(ctx context.Context, complete completeFunc, systemPrompt, content string) ([]ScenarioStep, error) {
+	steps, err := ExtractJSONFromResponse(content)
+	if err == nil {
+		return steps, nil
+	}
+
+	reformatPrompt, promptErr := BuildReformatPrompt(content, err)
+	if promptErr != nil {
+		return nil, err
+	}
+
+	reformatted, completeErr := complete(ctx, systemPrompt, reformatPrompt)
+	if completeErr != nil {
+		return nil, fmt.Errorf("%w (reformat request failed: %v)", err, completeErr)
+	}
+
+	steps, reformatErr := ExtractJSONFromResponse(reformatted)
+	if reformatErr != nil {
+		return nil, fmt.Errorf("%w (reformatted response also invalid: %v)", err, reformatErr)
+	}
+	return steps, nil
+}
@@ -33,10 +33,12 @@ type ParameterDef struct {
 
 // StepResult represents the result of executing a step
 type StepResult struct {
-	StepNumber  int    `json:"stepNumber"`
-	Description string `json:"description"`
-	Action      string `json:"action"`
-	Status      string `json:"status"`
+	StepNumber  int                    `json:"stepNumber"`
+	Description string                 `json:"description"`
+	Action      string                 `json:"action"`
+	Params      map[string]interface{} `json:"params,omitempty"`
+	Status      string                 `json:"status"`
+	Error       string                 `json:"error,omitempty"`
 }
 
 // ScenarioContext contains context information for scenario execution
@@ -55,9 +57,10 @@ type ParseResponse struct {
 
 // ValidationResponse represents the response from validating a step
 type ValidationResponse struct {
-	Valid   bool   `json:"valid"`
-	Message string `json:"message,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Valid         bool          `json:"valid"`
+	Message       string        `json:"message,omitempty"`
+	Error         string        `json:"error,omitempty"`
+	CorrectedStep *ScenarioStep `json:"correctedStep,omitempty"` // Suggested replacement for a failed step
 }
 
 // Message represents a chat message for LLM conversation
@@ -41,6 +41,31 @@ func WithOnStepEnd(fn func(stepNum int, result StepResult)) Option {
 	}
 }
 
+// WithSelfCorrect enables LLM-assisted self-correction
+// When a step fails, the provider is asked for a corrected step which is retried once
+func WithSelfCorrect(enabled bool) Option {
+	return func(o *Options) {
+		o.SelfCorrect = enabled
+	}
+}
+
+// WithChunkedParse parses the scenario in blank-line-separated chunks of at most maxChars characters
+// Use this for long scenarios whose parsed steps would exceed the provider's MaxTokens
+func WithChunkedParse(maxChars int) Option {
+	return func(o *Options) {
+		o.ChunkSize = maxChars
+	}
+}
+
+// WithContinueOnFailure keeps running the remaining steps after a failed assertion
+// Every failure is collected in the Result. A failed action still stops the scenario,
+// since the steps after it usually depend on its effect.
+func WithContinueOnFailure(enabled bool) Option {
+	return func(o *Options) {
+		o.ContinueOnFailure = enabled
+	}
+}
+
 // WithWebhook sets the webhook configuration for notifications
 func WithWebhook(cfg *config.WebhookConfig) Option {
 	return func(o *Options) {
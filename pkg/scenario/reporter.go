@@ -20,10 +20,12 @@ type Summary struct {
 	TotalScenarios int
 	PassedCount    int
 	FailedCount    int
+	CancelledCount int // Scenarios interrupted by cancelling the run; not counted as failed
 	TotalSteps     int
 	PassedSteps    int
 	FailedSteps    int
 	TotalDuration  time.Duration
+	Text           string // Natural-language summary (set by Runner.GenerateSummary)
 }
 
 // NewSummary creates a new summary from scenario results
@@ -36,6 +38,8 @@ func NewSummary(results ...*Result) *Summary {
 	for _, r := range results {
 		if r.Success {
 			s.PassedCount++
+		} else if r.Cancelled {
+			s.CancelledCount++
 		} else {
 			s.FailedCount++
 		}
@@ -50,7 +54,7 @@ func NewSummary(results ...*Result) *Summary {
 
 // Success returns true if all scenarios passed
 func (s *Summary) Success() bool {
-	return s.FailedCount == 0
+	return s.FailedCount == 0 && s.CancelledCount == 0
 }
 
 // ConsoleReporter reports results to the console
@@ -105,7 +109,11 @@ func (r *ConsoleReporter) ReportResult(result *Result) {
 	if result.Success {
 		fmt.Fprintln(r.writer, "Result: PASSED")
 	} else {
-		fmt.Fprintln(r.writer, "Result: FAILED")
+		if result.Cancelled {
+			fmt.Fprintln(r.writer, "Result: CANCELLED")
+		} else {
+			fmt.Fprintln(r.writer, "Result: FAILED")
+		}
 		if result.Error != nil {
 			fmt.Fprintf(r.writer, "Error: %v\n", result.Error)
 		}
@@ -124,7 +132,9 @@ func (r *ConsoleReporter) ReportSummary(summary *Summary) {
 	// List all scenarios
 	for _, result := range summary.Results {
 		icon := "[PASS]"
-		if !result.Success {
+		if result.Cancelled {
+			icon = "[CANC]"
+		} else if !result.Success {
 			icon = "[FAIL]"
 		}
 		fmt.Fprintf(r.writer, "%s %s (%d/%d steps, %v)\n",
@@ -134,8 +144,13 @@ func (r *ConsoleReporter) ReportSummary(summary *Summary) {
 	fmt.Fprintln(r.writer, strings.Repeat("-", 60))
 
 	// Summary stats
-	fmt.Fprintf(r.writer, "Scenarios: %d passed, %d failed, %d total\n",
-		summary.PassedCount, summary.FailedCount, summary.TotalScenarios)
+	if summary.CancelledCount > 0 {
+		fmt.Fprintf(r.writer, "Scenarios: %d passed, %d failed, %d cancelled, %d total\n",
+			summary.PassedCount, summary.FailedCount, summary.CancelledCount, summary.TotalScenarios)
+	} else {
+		fmt.Fprintf(r.writer, "Scenarios: %d passed, %d failed, %d total\n",
+			summary.PassedCount, summary.FailedCount, summary.TotalScenarios)
+	}
 	fmt.Fprintf(r.writer, "Steps:     %d passed, %d failed, %d total\n",
 		summary.PassedSteps, summary.FailedSteps, summary.TotalSteps)
 	fmt.Fprintf(r.writer, "Duration:  %v\n", summary.TotalDuration.Round(time.Millisecond))
@@ -144,6 +159,8 @@ func (r *ConsoleReporter) ReportSummary(summary *Summary) {
 
 	if summary.Success() {
 		fmt.Fprintln(r.writer, "Result: ALL PASSED")
+	} else if summary.FailedCount == 0 {
+		fmt.Fprintln(r.writer, "Result: CANCELLED")
 	} else {
 		fmt.Fprintln(r.writer, "Result: SOME FAILED")
 
@@ -151,7 +168,7 @@ func (r *ConsoleReporter) ReportSummary(summary *Summary) {
 		fmt.Fprintln(r.writer)
 		fmt.Fprintln(r.writer, "Failed scenarios:")
 		for _, result := range summary.Results {
-			if !result.Success {
+			if !result.Success && !result.Cancelled {
 				fmt.Fprintf(r.writer, "  - %s\n", result.Scenario)
 				for _, step := range result.Steps {
 					if step.Status == StepStatusFailed {
@@ -177,6 +194,8 @@ func (r *ConsoleReporter) getStatusIcon(status StepStatus) string {
 		return "[FAIL]"
 	case StepStatusSkipped:
 		return "[SKIP]"
+	case StepStatusCancelled:
+		return "[CANC]"
 	case StepStatusRunning:
 		return "[RUN ]"
 	default:
@@ -196,8 +215,11 @@ func StepReporter() (func(int, ScenarioStep), func(int, StepResult)) {
 
 	onEnd := func(stepNum int, result StepResult) {
 		icon := "[PASS]"
-		if result.Status == StepStatusFailed {
+		switch result.Status {
+		case StepStatusFailed:
 			icon = "[FAIL]"
+		case StepStatusCancelled:
+			icon = "[CANC]"
 		}
 		fmt.Printf("  %s Step %d completed in %v\n", icon, stepNum, result.Duration)
 		if result.Error != nil {
@@ -36,12 +36,29 @@ func NewRunner(agent *agent.Agent, cfg *config.AIConfig, opts ...Option) (*Runne
 		return nil, fmt.Errorf("failed to create LLM provider: %w", err)
 	}
 
+	return newRunner(agent, provider, options), nil
+}
+
+// NewStructuredRunner creates a scenario runner without an LLM provider
+// It can only run structured scenario files; natural-language scenarios, self-correction and
+// GenerateSummary need a runner created with NewRunner.
+func NewStructuredRunner(agent *agent.Agent, opts ...Option) *Runner {
+	options := DefaultOptions()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return newRunner(agent, nil, options)
+}
+
+// newRunner wires the executor and webhook client for a runner
+func newRunner(agent *agent.Agent, provider llm.Provider, options Options) *Runner {
 	executor := NewExecutor(agent, func(o *ExecutorOptions) {
 		o.Timeout = options.Timeout
 		o.StepTimeout = options.StepTimeout
 		o.Verbose = options.Verbose
 		o.OnStepStart = options.OnStepStart
 		o.OnStepEnd = options.OnStepEnd
+		o.ContinueOnFailure = options.ContinueOnFailure
 	})
 
 	// Initialize webhook client if configured
@@ -50,12 +67,51 @@ func NewRunner(agent *agent.Agent, cfg *config.AIConfig, opts ...Option) (*Runne
 		webhookClient = webhook.NewClient(options.WebhookConfig)
 	}
 
-	return &Runner{
+	runner := &Runner{
 		agent:    agent,
 		provider: provider,
 		executor: executor,
 		options:  options,
 		webhook:  webhookClient,
+	}
+
+	if options.SelfCorrect && provider != nil {
+		executor.options.Corrector = runner.correctStep
+	}
+
+	return runner
+}
+
+// correctStep asks the LLM provider for a corrected version of a failed step
+func (r *Runner) correctStep(ctx context.Context, step ScenarioStep, result StepResult) (*ScenarioStep, error) {
+	errStr := ""
+	if result.Error != nil {
+		errStr = result.Error.Error()
+	}
+
+	llmCtx := convertToLLMContext(r.executor.GetScenarioContext())
+	resp, err := r.provider.ValidateStep(ctx, &llm.StepResult{
+		StepNumber:  result.StepNumber,
+		Description: step.Description,
+		Action:      step.Action,
+		Params:      step.Params,
+		Status:      string(result.Status),
+		Error:       errStr,
+	}, llmCtx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%s", resp.Error)
+	}
+	if resp.Valid || resp.CorrectedStep == nil {
+		return nil, nil
+	}
+
+	return &ScenarioStep{
+		Action:      resp.CorrectedStep.Action,
+		Description: resp.CorrectedStep.Description,
+		Params:      resp.CorrectedStep.Params,
 	}, nil
 }
 
@@ -65,7 +121,12 @@ func (r *Runner) RunFromString(ctx context.Context, scenarioText string) (*Resul
 }
 
 // RunFromFile executes a scenario from a file
+// Structured files (see IsStructuredFile) are run as-is without the LLM.
 func (r *Runner) RunFromFile(ctx context.Context, path string) (*Result, error) {
+	if IsStructuredFile(path) {
+		return r.RunFromStructuredFile(ctx, path)
+	}
+
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read scenario file: %w", err)
@@ -80,6 +141,10 @@ func (r *Runner) RunFromFile(ctx context.Context, path string) (*Result, error)
 
 // run executes a scenario
 func (r *Runner) run(ctx context.Context, scenarioText string) (*Result, error) {
+	if r.provider == nil {
+		return nil, fmt.Errorf("natural-language scenarios need an LLM provider (use NewRunner, or a structured .steps.yml/.steps.json file)")
+	}
+
 	// Build scenario context for LLM
 	sctx := r.executor.GetScenarioContext()
 
@@ -91,7 +156,13 @@ func (r *Runner) run(ctx context.Context, scenarioText string) (*Result, error)
 		fmt.Println("Parsing scenario with LLM...")
 	}
 
-	parseResp, err := r.provider.ParseScenario(ctx, scenarioText, llmCtx)
+	var parseResp *llm.ParseResponse
+	var err error
+	if r.options.ChunkSize > 0 {
+		parseResp, err = llm.ParseScenarioInChunks(ctx, r.provider, scenarioText, llmCtx, r.options.ChunkSize)
+	} else {
+		parseResp, err = r.provider.ParseScenario(ctx, scenarioText, llmCtx)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse scenario: %w", err)
 	}
@@ -112,9 +183,11 @@ func (r *Runner) run(ctx context.Context, scenarioText string) (*Result, error)
 	}
 
 	// Convert LLM steps to scenario steps
-	steps := convertFromLLMSteps(parseResp.Steps)
+	return r.execute(ctx, convertFromLLMSteps(parseResp.Steps))
+}
 
-	// Execute parsed steps
+// execute runs parsed steps and sends the webhook notification
+func (r *Runner) execute(ctx context.Context, steps []ScenarioStep) (*Result, error) {
 	result, err := r.executor.Execute(ctx, steps)
 
 	// Send webhook notification if configured
@@ -139,6 +212,8 @@ func convertToWebhookResult(r *Result) *webhook.ScenarioResult {
 			status = webhook.StepStatusPassed
 		} else if s.Status == StepStatusFailed {
 			status = webhook.StepStatusFailed
+		} else if s.Status == StepStatusCancelled {
+			status = webhook.StepStatusCancelled
 		}
 		steps[i] = webhook.StepResult{
 			StepNumber:  s.StepNumber,
@@ -156,6 +231,7 @@ func convertToWebhookResult(r *Result) *webhook.ScenarioResult {
 		FailedSteps: r.FailedSteps,
 		Duration:    r.Duration,
 		Success:     r.Success,
+		Cancelled:   r.Cancelled,
 	}
 }
 
@@ -268,6 +344,7 @@ func convertToWebhookSummary(s *Summary) *webhook.Summary {
 		TotalScenarios: s.TotalScenarios,
 		PassedCount:    s.PassedCount,
 		FailedCount:    s.FailedCount,
+		CancelledCount: s.CancelledCount,
 		TotalSteps:     s.TotalSteps,
 		PassedSteps:    s.PassedSteps,
 		FailedSteps:    s.FailedSteps,
@@ -276,9 +353,18 @@ func convertToWebhookSummary(s *Summary) *webhook.Summary {
 }
 
 // GenerateSummary generates a natural language summary using LLM
+// The generated text is also stored in summary.Text so it is included in WriteReport output
 func (r *Runner) GenerateSummary(ctx context.Context, summary *Summary) (string, error) {
+	if r.provider == nil {
+		return "", fmt.Errorf("summary generation needs an LLM provider")
+	}
 	input := convertToLLMSummaryInput(summary)
-	return r.provider.GenerateSummary(ctx, input)
+	text, err := r.provider.GenerateSummary(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	summary.Text = text
+	return text, nil
 }
 
 func convertToLLMSummaryInput(s *Summary) *llm.SummaryInput {
@@ -317,6 +403,9 @@ func convertToLLMSummaryInput(s *Summary) *llm.SummaryInput {
 
 // Close cleans up resources
 func (r *Runner) Close() error {
+	if r.provider == nil {
+		return nil
+	}
 	return r.provider.Close()
 }
 
@@ -356,6 +445,10 @@ func RunFromFile(path string, agent *agent.Agent, opts ...Option) (*Result, erro
 		opts = append(opts, WithWebhook(&cfg.Webhook))
 	}
 
+	if IsStructuredFile(path) {
+		return RunFromStructuredFile(path, agent, opts...)
+	}
+
 	runner, err := NewRunner(agent, &cfg.AI, opts...)
 	if err != nil {
 		return nil, err
@@ -390,6 +483,10 @@ func RunFromFileWithConfig(path string, agent *agent.Agent, cfg *config.Config,
 		opts = append(opts, WithWebhook(&cfg.Webhook))
 	}
 
+	if IsStructuredFile(path) {
+		return RunFromStructuredFile(path, agent, opts...)
+	}
+
 	runner, err := NewRunner(agent, &cfg.AI, opts...)
 	if err != nil {
 		return nil, err
@@ -408,6 +505,10 @@ type Options struct {
 	OnStepStart   func(stepNum int, step ScenarioStep)
 	OnStepEnd     func(stepNum int, result StepResult)
 	WebhookConfig *config.WebhookConfig
+	SelfCorrect   bool // Ask the LLM to correct and retry failed steps once
+	ChunkSize     int  // Parse the scenario in chunks of at most this many characters (0 = whole scenario)
+	// ContinueOnFailure keeps running the remaining steps after a failed assertion
+	ContinueOnFailure bool
 }
 
 // DefaultOptions returns default options
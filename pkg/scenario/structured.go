@@ -0,0 +1,84 @@
+package scenario
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gollilla/best/pkg/agent"
+	"gopkg.in/yaml.v3"
+)
+
+// File suffixes of structured scenario files, which hold pre-parsed steps instead of natural language
+const (
+	StructuredYAMLSuffix = ".steps.yml"
+	StructuredJSONSuffix = ".steps.json"
+)
+
+// IsStructuredFile reports whether path names a structured scenario file (.steps.yml, .steps.yaml or .steps.json)
+func IsStructuredFile(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, StructuredYAMLSuffix) ||
+		strings.HasSuffix(lower, ".steps.yaml") ||
+		strings.HasSuffix(lower, StructuredJSONSuffix)
+}
+
+// LoadStructuredFile reads the steps of a structured scenario file
+// The file is a YAML or JSON list of steps, each with an action and optional description and params,
+// in the same shape the LLM produces.
+func LoadStructuredFile(path string) ([]ScenarioStep, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario file: %w", err)
+	}
+
+	var steps []ScenarioStep
+	if strings.HasSuffix(strings.ToLower(path), StructuredJSONSuffix) {
+		err = json.Unmarshal(data, &steps)
+	} else {
+		err = yaml.Unmarshal(data, &steps)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse structured scenario %s: %w", path, err)
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("no steps in structured scenario %s", path)
+	}
+	for i, step := range steps {
+		if step.Action == "" {
+			return nil, fmt.Errorf("step %d in %s has no action", i+1, path)
+		}
+	}
+
+	return steps, nil
+}
+
+// RunFromStructuredFile executes the steps of a structured scenario file without calling the LLM
+func (r *Runner) RunFromStructuredFile(ctx context.Context, path string) (*Result, error) {
+	steps, err := LoadStructuredFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.options.Verbose {
+		fmt.Printf("Loaded %d steps from %s\n", len(steps), path)
+	}
+
+	result, err := r.execute(ctx, steps)
+	if result != nil {
+		result.Scenario = path
+	}
+	return result, err
+}
+
+// RunFromStructuredFile is a convenience function to run a structured scenario file
+// No LLM provider is created, so it works without AI settings
+func RunFromStructuredFile(path string, agent *agent.Agent, opts ...Option) (*Result, error) {
+	runner := NewStructuredRunner(agent, opts...)
+	defer runner.Close()
+
+	return runner.RunFromStructuredFile(context.Background(), path)
+}
@@ -9,18 +9,19 @@ import (
 type StepStatus string
 
 const (
-	StepStatusPending  StepStatus = "pending"
-	StepStatusRunning  StepStatus = "running"
-	StepStatusPassed   StepStatus = "passed"
-	StepStatusFailed   StepStatus = "failed"
-	StepStatusSkipped  StepStatus = "skipped"
+	StepStatusPending   StepStatus = "pending"
+	StepStatusRunning   StepStatus = "running"
+	StepStatusPassed    StepStatus = "passed"
+	StepStatusFailed    StepStatus = "failed"
+	StepStatusSkipped   StepStatus = "skipped"
+	StepStatusCancelled StepStatus = "cancelled" // interrupted because the run was cancelled (e.g. Ctrl-C)
 )
 
 // ScenarioStep represents a single step in a scenario
 type ScenarioStep struct {
-	Action      string                 `json:"action"`
-	Description string                 `json:"description,omitempty"`
-	Params      map[string]interface{} `json:"params,omitempty"`
+	Action      string                 `json:"action" yaml:"action"`
+	Description string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	Params      map[string]interface{} `json:"params,omitempty" yaml:"params,omitempty"`
 }
 
 // StepResult represents the result of executing a scenario step
@@ -31,6 +32,9 @@ type StepResult struct {
 	Status      StepStatus    `json:"status"`
 	Duration    time.Duration `json:"duration"`
 	Error       error         `json:"error,omitempty"`
+	// Corrected is set when the step was replaced by a self-corrected step; OriginalError is why the original failed
+	Corrected     bool  `json:"corrected,omitempty"`
+	OriginalError error `json:"originalError,omitempty"`
 }
 
 // Result represents the result of executing a scenario
@@ -42,6 +46,7 @@ type Result struct {
 	FailedSteps int           `json:"failedSteps"`
 	Duration    time.Duration `json:"duration"`
 	Success     bool          `json:"success"`
+	Cancelled   bool          `json:"cancelled"` // The run was cancelled before the scenario finished
 	Error       error         `json:"error,omitempty"`
 }
 
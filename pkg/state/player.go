@@ -18,6 +18,8 @@ func CreateInitialState() *types.PlayerState {
 			Pitch: 0,
 		},
 		Health:     20,
+		MaxHealth:  20,
+		Weather:    types.WeatherClear,
 		Gamemode:   0,
 		Dimension:  "overworld",
 		IsOnGround: false,
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"math"
+	"strings"
 	"time"
 )
 
@@ -11,6 +14,111 @@ type Position struct {
 	Z float64
 }
 
+// Offset returns the position one block away in the direction of face
+// Use it to find where a block placed against a face ends up.
+func (p Position) Offset(face BlockFace) Position {
+	switch face {
+	case BlockFaceDown:
+		p.Y--
+	case BlockFaceUp:
+		p.Y++
+	case BlockFaceNorth:
+		p.Z--
+	case BlockFaceSouth:
+		p.Z++
+	case BlockFaceWest:
+		p.X--
+	case BlockFaceEast:
+		p.X++
+	}
+	return p
+}
+
+// BlockFace is a side of a block, as used by block interactions
+type BlockFace int32
+
+// Block faces in protocol order
+const (
+	BlockFaceDown BlockFace = iota
+	BlockFaceUp
+	BlockFaceNorth
+	BlockFaceSouth
+	BlockFaceWest
+	BlockFaceEast
+)
+
+// Opposite returns the face on the other side of the block
+func (f BlockFace) Opposite() BlockFace {
+	return f ^ 1
+}
+
+// ParseBlockFace parses a face name as returned by String ("down", "up", "north", ...)
+func ParseBlockFace(name string) (BlockFace, bool) {
+	for face := BlockFaceDown; face <= BlockFaceEast; face++ {
+		if face.String() == name {
+			return face, true
+		}
+	}
+	return 0, false
+}
+
+// String returns the face name
+func (f BlockFace) String() string {
+	switch f {
+	case BlockFaceDown:
+		return "down"
+	case BlockFaceUp:
+		return "up"
+	case BlockFaceNorth:
+		return "north"
+	case BlockFaceSouth:
+		return "south"
+	case BlockFaceWest:
+		return "west"
+	case BlockFaceEast:
+		return "east"
+	default:
+		return "unknown"
+	}
+}
+
+// MoveCorrection represents the server pulling the player back to an authoritative position
+type MoveCorrection struct {
+	Position Position
+	Source   string // "move_player" (MovePlayer reset) or "correct_prediction" (CorrectPlayerMovePrediction)
+}
+
+// Weather values of PlayerState.Weather
+const (
+	WeatherClear   = "clear"
+	WeatherRain    = "rain"
+	WeatherThunder = "thunder"
+)
+
+// Ticks in a Minecraft day and the night interval within it
+const (
+	TicksPerDay = 24000
+	NightStart  = 13000
+	NightEnd    = 23000
+)
+
+// TimeOfDay returns the world time within the current day (0-23999)
+func TimeOfDay(time int32) int32 {
+	return ((time % TicksPerDay) + TicksPerDay) % TicksPerDay
+}
+
+// IsNight reports whether the world time falls in the night (13000-22999 within the day)
+func IsNight(time int32) bool {
+	t := TimeOfDay(time)
+	return t >= NightStart && t < NightEnd
+}
+
+// Experience is the player's experience level and progress towards the next level
+type Experience struct {
+	Level    int32
+	Progress float32 // 0.0-1.0
+}
+
 // Rotation represents yaw and pitch
 type Rotation struct {
 	Yaw   float32
@@ -23,6 +131,10 @@ type PlayerState struct {
 	Position        Position
 	Rotation        Rotation
 	Health          float32
+	MaxHealth       float32 // Maximum of the health attribute (20 unless changed by the server)
+	Experience      Experience
+	Time            int32  // World time in ticks; keeps counting past 24000 (see TimeOfDay)
+	Weather         string // WeatherClear, WeatherRain or WeatherThunder
 	Gamemode        int32
 	Dimension       string
 	IsOnGround      bool
@@ -30,12 +142,45 @@ type PlayerState struct {
 	Scoreboard      *ScoreboardState // Scoreboard state
 }
 
+// FormatHearts renders health as hearts followed by the exact values, e.g. "♥♥♥♡♡♡♡♡♡♡ (5/20)"
+// Each heart stands for 2 health and partially filled hearts count as full. A maxHealth of 0 or
+// less is treated as 20.
+func FormatHearts(health, maxHealth float32) string {
+	if maxHealth <= 0 {
+		maxHealth = 20
+	}
+	total := int(math.Ceil(float64(maxHealth) / 2))
+	filled := min(max(int(math.Ceil(float64(health)/2)), 0), total)
+	return fmt.Sprintf("%s%s (%g/%g)",
+		strings.Repeat("♥", filled), strings.Repeat("♡", total-filled), health, maxHealth)
+}
+
 // ScoreboardState tracks the current scoreboard state
 type ScoreboardState struct {
 	Objectives map[string]*ScoreboardObjective // Map of objective name to objective
 	Entries    map[int64]*ScoreboardEntry      // Map of entry ID to entry
 }
 
+// Clone returns a deep copy of the scoreboard state, or nil if s is nil
+func (s *ScoreboardState) Clone() *ScoreboardState {
+	if s == nil {
+		return nil
+	}
+	clone := &ScoreboardState{
+		Objectives: make(map[string]*ScoreboardObjective, len(s.Objectives)),
+		Entries:    make(map[int64]*ScoreboardEntry, len(s.Entries)),
+	}
+	for name, objective := range s.Objectives {
+		copied := *objective
+		clone.Objectives[name] = &copied
+	}
+	for id, entry := range s.Entries {
+		copied := *entry
+		clone.Entries[id] = &copied
+	}
+	return clone
+}
+
 // ScoreboardObjective represents a scoreboard objective
 type ScoreboardObjective struct {
 	Name        string
@@ -46,12 +191,20 @@ type ScoreboardObjective struct {
 
 // CommandOutput represents the result of a command execution (CommandOutputPacket)
 type CommandOutput struct {
-	Command    string
+	Command    string // Originating command line without slash (commandSendMethod "request" only)
+	Origin     string // Origin UUID of the CommandRequest the output answers
 	Success    bool
 	Output     string
-	StatusCode int32
+	StatusCode int32 // CommandStatusSuccess or CommandStatusFailure
+	OutputType byte  // Output type of the CommandOutput packet (e.g. 3 = all output)
 }
 
+// CommandOutput status codes
+const (
+	CommandStatusSuccess int32 = 0
+	CommandStatusFailure int32 = 1
+)
+
 // ChatMessage represents a chat message
 type ChatMessage struct {
 	Type      string
@@ -59,6 +212,31 @@ type ChatMessage struct {
 	Message   string
 	Timestamp int64
 	XUID      string
+	Recipient string // Username of the agent that received the message
+
+	TranslationKey string   // Translation key without formatting codes (e.g. "multiplayer.player.joined"), if any
+	Parameters     []string // Translation parameters
+}
+
+// ServerTick is a server tick observed in a received packet
+// Ticks from different sources are separate counters and must not be compared with each other.
+type ServerTick struct {
+	Source string // TickSourceActor or TickSourceTime
+	Tick   uint64
+}
+
+// Server tick sources
+const (
+	TickSourceActor = "actor" // Tick field of actor and attribute packets
+	TickSourceTime  = "time"  // World time from SetTime
+)
+
+// PlayerListEntry represents a player in the server's player list
+type PlayerListEntry struct {
+	UUID           string // Empty if the player was only seen in a join message
+	Name           string
+	XUID           string
+	EntityUniqueID int64
 }
 
 // Form represents a form (modal, action, or custom)
@@ -183,12 +361,29 @@ type InventoryItem struct {
 	Enchantments []Enchantment
 }
 
+// InventoryChange describes how the inventory changed between two snapshots
+// Added and Removed hold per-item totals (Slot is not set), e.g. {ID: "minecraft:diamond", Count: 5}
+type InventoryChange struct {
+	Added    []InventoryItem
+	Removed  []InventoryItem
+	Previous []InventoryItem
+	Current  []InventoryItem
+}
+
 // Enchantment represents an enchantment on an item
 type Enchantment struct {
 	ID    string
 	Level int32
 }
 
+// Death describes the agent dying
+// Cause and Messages come from the DeathInfo packet and are empty when the server only sent health 0.
+type Death struct {
+	Cause    string
+	Messages []string
+	Position Position // Where the agent died
+}
+
 // Effect represents a status effect
 type Effect struct {
 	ID        string
@@ -200,11 +395,36 @@ type Effect struct {
 // Entity represents an entity in the world
 type Entity struct {
 	RuntimeID int64
+	UniqueID  int64 // Key used by the server when the entity is removed
 	Type      string
 	Position  Position
 	NameTag   *string
 }
 
+// EntityMove describes an entity moving, as sent by MoveActorAbsolute and MoveActorDelta
+// MoveActorDelta omits unchanged coordinates, so only the flagged coordinates of Position are set.
+type EntityMove struct {
+	RuntimeID int64
+	Position  Position
+	HasX      bool
+	HasY      bool
+	HasZ      bool
+}
+
+// Apply returns pos with the moved coordinates replaced
+func (m *EntityMove) Apply(pos Position) Position {
+	if m.HasX {
+		pos.X = m.Position.X
+	}
+	if m.HasY {
+		pos.Y = m.Position.Y
+	}
+	if m.HasZ {
+		pos.Z = m.Position.Z
+	}
+	return pos
+}
+
 // Block represents a block in the world
 type Block struct {
 	Name      string
@@ -258,6 +478,15 @@ type TitleDisplay struct {
 	FadeOut int32
 }
 
+// BossBar represents a boss bar shown to the player
+type BossBar struct {
+	EntityUniqueID int64   // Unique ID of the boss entity the bar belongs to
+	Title          string
+	Percentage     float32 // 0.0-1.0
+	Color          string  // "grey", "blue", "red", "green", "yellow", "purple" or "white"
+	Removed        bool    // Set on the EventBossBar emitted when the bar is hidden
+}
+
 // SoundPlay represents a sound being played
 type SoundPlay struct {
 	Name     string
@@ -285,8 +514,15 @@ type ClientOptions struct {
 	Port     uint16
 	Username string
 	XUID     string        // Optional: If empty, auto-generated 16-digit XUID will be used
+	Identity string        // Optional: If empty, a random identity UUID will be used
 	Timeout  time.Duration
 	Version  string
+
+	SpawnTimeout time.Duration // Maximum time to wait for the spawn handshake after login
+
+	DeclineResourcePacks bool // Skip downloading resource packs offered during login
+
+	SessionRecordPath string // Optional: If set, all received packets are recorded to this file
 }
 
 // FormResponse can be null, bool (modal), int (action), or []interface{} (custom)
@@ -0,0 +1,26 @@
+package types
+
+import "testing"
+
+func TestFormatHearts(t *testing.T) {
+	tests := []struct {
+		health    float32
+		maxHealth float32
+		want      string
+	}{
+		{20, 20, "♥♥♥♥♥♥♥♥♥♥ (20/20)"},
+		{5, 20, "♥♥♥♡♡♡♡♡♡♡ (5/20)"},
+		{0, 20, "♡♡♡♡♡♡♡♡♡♡ (0/20)"},
+		{0.5, 20, "♥♡♡♡♡♡♡♡♡♡ (0.5/20)"},
+		{10, 0, "♥♥♥♥♥♡♡♡♡♡ (10/20)"},
+		{30, 20, "♥♥♥♥♥♥♥♥♥♥ (30/20)"},
+		{-1, 20, "♡♡♡♡♡♡♡♡♡♡ (-1/20)"},
+		{6, 6, "♥♥♥ (6/6)"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatHearts(tt.health, tt.maxHealth); got != tt.want {
+			t.Errorf("FormatHearts(%v, %v) = %q, want %q", tt.health, tt.maxHealth, got, tt.want)
+		}
+	}
+}
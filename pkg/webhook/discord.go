@@ -8,6 +8,10 @@ const (
 	ColorBlue   = 0x0099FF
 )
 
+// maxEmbedDescriptionLength is Discord's limit for an embed description
+// Payloads exceeding it are rejected with 400 Bad Request
+const maxEmbedDescriptionLength = 4096
+
 // DiscordWebhookPayload represents the payload sent to Discord webhooks
 type DiscordWebhookPayload struct {
 	Content   string         `json:"content,omitempty"`
@@ -5,10 +5,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gollilla/best/pkg/config"
 )
@@ -27,8 +30,9 @@ const (
 type StepStatus string
 
 const (
-	StepStatusPassed StepStatus = "passed"
-	StepStatusFailed StepStatus = "failed"
+	StepStatusPassed    StepStatus = "passed"
+	StepStatusFailed    StepStatus = "failed"
+	StepStatusCancelled StepStatus = "cancelled"
 )
 
 // ScenarioResult contains scenario execution results for webhook notifications
@@ -40,6 +44,7 @@ type ScenarioResult struct {
 	FailedSteps int
 	Duration    time.Duration
 	Success     bool
+	Cancelled   bool // The run was cancelled before the scenario finished
 }
 
 // StepResult contains step execution result for webhook notifications
@@ -56,6 +61,7 @@ type Summary struct {
 	TotalScenarios int
 	PassedCount    int
 	FailedCount    int
+	CancelledCount int
 	TotalSteps     int
 	PassedSteps    int
 	FailedSteps    int
@@ -72,6 +78,8 @@ func NewSummary(results ...*ScenarioResult) *Summary {
 	for _, r := range results {
 		if r.Success {
 			s.PassedCount++
+		} else if r.Cancelled {
+			s.CancelledCount++
 		} else {
 			s.FailedCount++
 		}
@@ -86,9 +94,12 @@ func NewSummary(results ...*ScenarioResult) *Summary {
 
 // Success returns true if all scenarios passed
 func (s *Summary) Success() bool {
-	return s.FailedCount == 0
+	return s.FailedCount == 0 && s.CancelledCount == 0
 }
 
+// DefaultTimeout is the send timeout used when WebhookConfig does not set one
+const DefaultTimeout = 10 * time.Second
+
 // Client is a webhook client
 type Client struct {
 	config     *config.WebhookConfig
@@ -96,13 +107,37 @@ type Client struct {
 }
 
 // NewClient creates a new webhook client
+// Send timeouts are applied per request from the config, see Timeout.
 func NewClient(cfg *config.WebhookConfig) *Client {
 	return &Client{
-		config: cfg,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		config:     cfg,
+		httpClient: &http.Client{},
+	}
+}
+
+// Timeout returns the send timeout for the given event type
+// The event-specific setting is used if present, then the default timeout, then DefaultTimeout.
+func (c *Client) Timeout(event EventType) time.Duration {
+	if c.config == nil {
+		return DefaultTimeout
+	}
+
+	seconds := 0
+	switch event {
+	case EventScenarioComplete, EventScenarioFailed:
+		seconds = c.config.ResultTimeout
+	case EventStepFailed:
+		seconds = c.config.StepTimeout
+	case EventSummary:
+		seconds = c.config.SummaryTimeout
+	}
+	if seconds <= 0 {
+		seconds = c.config.Timeout
 	}
+	if seconds <= 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(seconds) * time.Second
 }
 
 // IsEnabled returns true if webhook is configured
@@ -143,8 +178,11 @@ func (c *Client) NotifyScenarioResult(ctx context.Context, result *ScenarioResul
 	payload := DiscordWebhookPayload{
 		Embeds: []DiscordEmbed{embed},
 	}
+	if !result.Success && !result.Cancelled {
+		payload.Content = c.failureMentions()
+	}
 
-	return c.send(ctx, payload)
+	return c.send(ctx, eventType, payload)
 }
 
 // NotifyStepFailed sends a webhook notification for a failed step
@@ -161,10 +199,11 @@ func (c *Client) NotifyStepFailed(ctx context.Context, scenarioName string, step
 	}
 
 	payload := DiscordWebhookPayload{
-		Embeds: []DiscordEmbed{embed},
+		Content: c.failureMentions(),
+		Embeds:  []DiscordEmbed{embed},
 	}
 
-	return c.send(ctx, payload)
+	return c.send(ctx, EventStepFailed, payload)
 }
 
 // NotifySummary sends a webhook notification with test summary
@@ -177,16 +216,69 @@ func (c *Client) NotifySummary(ctx context.Context, summary *Summary) error {
 	payload := DiscordWebhookPayload{
 		Embeds: []DiscordEmbed{embed},
 	}
+	if summary.FailedCount > 0 {
+		payload.Content = c.failureMentions()
+	}
 
-	return c.send(ctx, payload)
+	return c.send(ctx, EventSummary, payload)
+}
+
+// TestConnection sends a small test message to verify the webhook URL and permissions
+// Unlike the Notify methods, it returns an error when the webhook is not configured
+func (c *Client) TestConnection(ctx context.Context) error {
+	if !c.IsEnabled() {
+		return fmt.Errorf("webhook URL is not configured")
+	}
+
+	embed := DiscordEmbed{
+		Title:       "Best webhook configured",
+		Description: "This is a test notification. Scenario results will be posted to this channel.",
+		Color:       ColorBlue,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Footer: &DiscordEmbedFooter{
+			Text: "Best - Minecraft Bedrock Testing",
+		},
+	}
+
+	payload := DiscordWebhookPayload{
+		Embeds: []DiscordEmbed{embed},
+	}
+
+	return c.send(ctx, "", payload)
+}
+
+// failureMentions builds the message content that pings MentionOnFailure targets
+// Plain IDs are treated as role IDs; entries already in "<@...>" form are used as-is
+func (c *Client) failureMentions() string {
+	if c.config == nil || len(c.config.MentionOnFailure) == 0 {
+		return ""
+	}
+
+	mentions := make([]string, 0, len(c.config.MentionOnFailure))
+	for _, id := range c.config.MentionOnFailure {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if strings.HasPrefix(id, "<@") {
+			mentions = append(mentions, id)
+		} else {
+			mentions = append(mentions, fmt.Sprintf("<@&%s>", id))
+		}
+	}
+
+	return strings.Join(mentions, " ")
 }
 
 func (c *Client) buildSummaryEmbed(summary *Summary) DiscordEmbed {
 	color := ColorGreen
 	status := "All Passed"
-	if !summary.Success() {
+	if summary.FailedCount > 0 {
 		color = ColorRed
 		status = "Some Failed"
+	} else if summary.CancelledCount > 0 {
+		color = ColorYellow
+		status = "Cancelled"
 	}
 
 	description := fmt.Sprintf(
@@ -198,38 +290,47 @@ func (c *Client) buildSummaryEmbed(summary *Summary) DiscordEmbed {
 		summary.TotalSteps,
 		summary.TotalDuration.Round(time.Millisecond),
 	)
+	if summary.CancelledCount > 0 {
+		description += fmt.Sprintf("\n**Cancelled**: %d scenario(s)", summary.CancelledCount)
+	}
 
-	// Add scenario results
-	description += "\n\n**Scenarios**:"
+	// Collect scenario result lines
+	scenarioLines := make([]string, 0, len(summary.Results))
 	for _, r := range summary.Results {
 		icon := "✅"
-		if !r.Success {
+		if r.Cancelled {
+			icon = "⏹️"
+		} else if !r.Success {
 			icon = "❌"
 		}
-		description += fmt.Sprintf("\n%s %s (%d/%d steps)", icon, r.Scenario, r.PassedSteps, r.TotalSteps)
+		scenarioLines = append(scenarioLines, fmt.Sprintf("\n%s %s (%d/%d steps)", icon, r.Scenario, r.PassedSteps, r.TotalSteps))
 	}
 
-	// Add failed scenario details
-	var failedDetails string
+	// Collect failed step lines
+	var failedLines []string
 	for _, r := range summary.Results {
 		if !r.Success {
 			for _, step := range r.Steps {
 				if step.Status == StepStatusFailed {
-					failedDetails += fmt.Sprintf("\n- **%s** Step %d: %s", r.Scenario, step.StepNumber, step.Description)
+					line := fmt.Sprintf("\n- **%s** Step %d: %s", r.Scenario, step.StepNumber, step.Description)
 					if step.Error != nil {
-						errStr := fmt.Sprintf("%v", step.Error)
-						if len(errStr) > 50 {
-							errStr = errStr[:50] + "..."
-						}
-						failedDetails += fmt.Sprintf(" (`%s`)", errStr)
+						line += fmt.Sprintf(" (`%s`)", truncateText(fmt.Sprintf("%v", step.Error), 50))
 					}
+					failedLines = append(failedLines, line)
 				}
 			}
 		}
 	}
-	if failedDetails != "" {
-		description += "\n\n**Failed Steps**:" + failedDetails
+
+	// Keep the description within Discord's embed limit.
+	// When there are failures, the scenario list may only use half of the
+	// remaining space so that the failed step details are always visible.
+	scenarioBudget := maxEmbedDescriptionLength
+	if len(failedLines) > 0 {
+		scenarioBudget = textLength(description) + (maxEmbedDescriptionLength-textLength(description))/2
 	}
+	description = appendLinesWithinLimit(description, "\n\n**Scenarios**:", scenarioLines, scenarioBudget)
+	description = appendLinesWithinLimit(description, "\n\n**Failed Steps**:", failedLines, maxEmbedDescriptionLength)
 
 	return DiscordEmbed{
 		Title:       "Test Summary",
@@ -245,7 +346,10 @@ func (c *Client) buildSummaryEmbed(summary *Summary) DiscordEmbed {
 func (c *Client) buildResultEmbed(result *ScenarioResult) DiscordEmbed {
 	color := ColorGreen
 	status := "Passed"
-	if !result.Success {
+	if result.Cancelled {
+		color = ColorYellow
+		status = "Cancelled"
+	} else if !result.Success {
 		color = ColorRed
 		status = "Failed"
 	}
@@ -260,15 +364,17 @@ func (c *Client) buildResultEmbed(result *ScenarioResult) DiscordEmbed {
 
 	// Add failed steps detail
 	if result.FailedSteps > 0 {
-		description += "\n\n**Failed Steps**:"
+		var failedLines []string
 		for _, step := range result.Steps {
 			if step.Status == StepStatusFailed {
-				description += fmt.Sprintf("\n- Step %d: %s", step.StepNumber, step.Description)
+				line := fmt.Sprintf("\n- Step %d: %s", step.StepNumber, step.Description)
 				if step.Error != nil {
-					description += fmt.Sprintf(" (`%v`)", step.Error)
+					line += fmt.Sprintf(" (`%v`)", step.Error)
 				}
+				failedLines = append(failedLines, line)
 			}
 		}
+		description = appendLinesWithinLimit(description, "\n\n**Failed Steps**:", failedLines, maxEmbedDescriptionLength)
 	}
 
 	return DiscordEmbed{
@@ -282,12 +388,67 @@ func (c *Client) buildResultEmbed(result *ScenarioResult) DiscordEmbed {
 	}
 }
 
-func (c *Client) send(ctx context.Context, payload DiscordWebhookPayload) error {
+// appendLinesWithinLimit appends a section header and its lines to description
+// without exceeding limit characters. Lines that do not fit are replaced with
+// an "...and N more" marker.
+func appendLinesWithinLimit(description, header string, lines []string, limit int) string {
+	if len(lines) == 0 {
+		return description
+	}
+
+	// Make sure at least the header and the overflow marker fit
+	if textLength(description)+textLength(header)+textLength(moreLinesMarker(len(lines))) > limit {
+		return description
+	}
+	description += header
+
+	for i, line := range lines {
+		// Reserve room for the marker that would follow this line
+		reserve := 0
+		if remaining := len(lines) - i - 1; remaining > 0 {
+			reserve = textLength(moreLinesMarker(remaining))
+		}
+
+		if textLength(description)+textLength(line)+reserve > limit {
+			return description + moreLinesMarker(len(lines)-i)
+		}
+		description += line
+	}
+
+	return description
+}
+
+// moreLinesMarker returns the marker used for lines omitted from an embed
+func moreLinesMarker(count int) string {
+	return fmt.Sprintf("\n...and %d more", count)
+}
+
+// textLength returns the number of characters in s
+// Discord limits are counted in characters, not bytes
+func textLength(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// truncateText shortens s to at most max characters, appending "..." when cut
+func truncateText(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max]) + "..."
+}
+
+// send posts the payload, giving up once the timeout for event has elapsed
+func (c *Client) send(ctx context.Context, event EventType, payload DiscordWebhookPayload) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal webhook payload: %w", err)
 	}
 
+	timeout := c.Timeout(event)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.URL, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("failed to create webhook request: %w", err)
@@ -297,6 +458,9 @@ func (c *Client) send(ctx context.Context, payload DiscordWebhookPayload) error
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("failed to send webhook: timed out after %v", timeout)
+		}
 		return fmt.Errorf("failed to send webhook: %w", err)
 	}
 	defer resp.Body.Close()
@@ -1,83 +1,245 @@
 package world
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 )
 
-// DecodeChunk decodes a chunk from raw data
-// This is a simplified implementation - full chunk decoding is complex
-// and would require palette handling, biome data, etc.
-func DecodeChunk(data []byte, chunkX, chunkZ int32) (*Chunk, error) {
-	if len(data) == 0 {
-		return nil, fmt.Errorf("empty chunk data")
+// Sub-chunk serialisation versions used in LevelChunk payloads
+const (
+	subChunkVersionLegacy  = 1 // single storage layer
+	subChunkVersionLayers  = 8 // storage layer count
+	subChunkVersionIndexed = 9 // storage layer count and sub-chunk Y index
+)
+
+// blocksPerSubChunk is the number of blocks in a 16x16x16 sub-chunk
+const blocksPerSubChunk = 4096
+
+// MinSubChunk returns the Y index of the lowest sub-chunk of a dimension
+// The overworld starts at Y -64; the nether and the end start at Y 0.
+func MinSubChunk(dimension int32) int8 {
+	if dimension == 0 {
+		return -4
+	}
+	return 0
+}
+
+// SubChunkCount returns the number of sub-chunks in a chunk column of a dimension
+// The overworld spans Y -64 to 319, the nether Y 0 to 127 and the end Y 0 to 255.
+func SubChunkCount(dimension int32) int {
+	switch dimension {
+	case 0:
+		return 24
+	case 1:
+		return 8
+	default:
+		return 16
 	}
+}
 
+// DecodeChunk decodes the sub-chunks of a LevelChunk payload sent without the blob cache
+// Sub-chunks without their own Y index are placed upwards from minSubChunk.
+// Only the first storage layer (blocks, not waterlogging) is kept, and the biomes, border blocks and
+// block entities that follow the sub-chunks are not read. The chunk is complete: servers omit the
+// all-air sub-chunks at the top.
+func DecodeChunk(data []byte, subChunkCount int, chunkX, chunkZ int32, minSubChunk int8) (*Chunk, error) {
 	chunk := &Chunk{
-		Position: ChunkPos{X: chunkX, Z: chunkZ},
-		SubChunks: make([]*SubChunk, 0),
+		Position:  ChunkPos{X: chunkX, Z: chunkZ},
+		SubChunks: make([]*SubChunk, 0, subChunkCount),
+		Complete:  true,
 	}
 
-	// TODO: Implement full chunk decoding with palette support
-	// For now, we just create an empty chunk structure
-	// Full implementation would:
-	// 1. Read the number of sub-chunks
-	// 2. For each sub-chunk, read the palette and block data
-	// 3. Decode the block runtime IDs using the palette
-	// 4. Store the decoded blocks
+	r := bytes.NewReader(data)
+	for i := 0; i < subChunkCount; i++ {
+		subChunk, err := decodeSubChunk(r, minSubChunk+int8(i))
+		if err != nil {
+			return nil, fmt.Errorf("sub-chunk %d of chunk (%d, %d): %w", i, chunkX, chunkZ, err)
+		}
+		chunk.SubChunks = append(chunk.SubChunks, subChunk)
+	}
 
 	return chunk, nil
 }
 
-// GetBlockAt returns the block runtime ID at the given position within the chunk
-func (c *Chunk) GetBlockAt(x, y, z int) (uint32, error) {
-	if x < 0 || x >= 16 || z < 0 || z >= 16 {
-		return 0, fmt.Errorf("position out of chunk bounds")
+// DecodeSubChunk decodes the payload of a single sub-chunk, as sent in SubChunk packets
+// y is used unless the payload carries its own sub-chunk Y index.
+func DecodeSubChunk(data []byte, y int8) (*SubChunk, error) {
+	return decodeSubChunk(bytes.NewReader(data), y)
+}
+
+// decodeSubChunk decodes a single network-encoded sub-chunk
+func decodeSubChunk(r *bytes.Reader, y int8) (*SubChunk, error) {
+	version, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("read version: %w", err)
 	}
 
-	subChunkY := y / 16
-	if subChunkY < 0 || subChunkY >= len(c.SubChunks) {
-		return 0, fmt.Errorf("y position out of range")
+	storageCount := byte(1)
+	switch version {
+	case subChunkVersionLegacy:
+	case subChunkVersionLayers, subChunkVersionIndexed:
+		if storageCount, err = r.ReadByte(); err != nil {
+			return nil, fmt.Errorf("read storage count: %w", err)
+		}
+		if version == subChunkVersionIndexed {
+			index, err := r.ReadByte()
+			if err != nil {
+				return nil, fmt.Errorf("read index: %w", err)
+			}
+			y = int8(index)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sub-chunk version %d", version)
 	}
 
-	subChunk := c.SubChunks[subChunkY]
-	if subChunk == nil {
-		return 0, nil // Air
+	subChunk := &SubChunk{Y: y}
+	for i := 0; i < int(storageCount); i++ {
+		blocks, err := decodeStorage(r)
+		if err != nil {
+			return nil, fmt.Errorf("storage %d: %w", i, err)
+		}
+		if i == 0 {
+			subChunk.Blocks = blocks
+		}
+	}
+	// A sub-chunk without storages is empty, which leaves Blocks nil (all air)
+	return subChunk, nil
+}
+
+// decodeStorage decodes a paletted block storage into runtime IDs indexed by (y*256)+(z*16)+x
+func decodeStorage(r *bytes.Reader) ([]uint32, error) {
+	header, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	bitsPerBlock := int(header >> 1)
+
+	var words []uint32
+	blocksPerWord := 0
+	switch bitsPerBlock {
+	case 0:
+		// Uniform storage: a single palette entry and no block data
+	case 1, 2, 3, 4, 5, 6, 8, 16:
+		blocksPerWord = 32 / bitsPerBlock
+		words = make([]uint32, (blocksPerSubChunk+blocksPerWord-1)/blocksPerWord)
+		if err := binary.Read(r, binary.LittleEndian, words); err != nil {
+			return nil, fmt.Errorf("read block data: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported bits per block %d", bitsPerBlock)
 	}
 
-	localY := y % 16
-	index := (localY * 256) + (z * 16) + x
+	paletteSize := int64(1)
+	if bitsPerBlock != 0 {
+		if paletteSize, err = binary.ReadVarint(r); err != nil {
+			return nil, fmt.Errorf("read palette size: %w", err)
+		}
+	}
+	if paletteSize <= 0 || paletteSize > blocksPerSubChunk {
+		return nil, fmt.Errorf("invalid palette size %d", paletteSize)
+	}
+
+	palette := make([]uint32, paletteSize)
+	for i := range palette {
+		id, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("read palette entry %d: %w", i, err)
+		}
+		palette[i] = uint32(int32(id))
+	}
+
+	blocks := make([]uint32, blocksPerSubChunk)
+	if bitsPerBlock == 0 {
+		for i := range blocks {
+			blocks[i] = palette[0]
+		}
+		return blocks, nil
+	}
 
-	if index < 0 || index >= len(subChunk.Blocks) {
-		return 0, fmt.Errorf("block index out of range")
+	mask := uint32(1)<<bitsPerBlock - 1
+	for i := 0; i < blocksPerSubChunk; i++ {
+		word := words[i/blocksPerWord]
+		index := (word >> (uint(i%blocksPerWord) * uint(bitsPerBlock))) & mask
+		if int(index) >= len(palette) {
+			return nil, fmt.Errorf("palette index %d out of range (palette size %d)", index, len(palette))
+		}
+
+		// Network storages are ordered x, z, y
+		x, z, y := i>>8, (i>>4)&15, i&15
+		blocks[(y*256)+(z*16)+x] = palette[index]
 	}
 
-	return subChunk.Blocks[index], nil
+	return blocks, nil
 }
 
-// SetBlockAt sets the block runtime ID at the given position within the chunk
-func (c *Chunk) SetBlockAt(x, y, z int, runtimeID uint32) error {
-	if x < 0 || x >= 16 || z < 0 || z >= 16 {
-		return fmt.Errorf("position out of chunk bounds")
+// subChunk returns the sub-chunk containing block Y coordinate y
+func (c *Chunk) subChunk(y int) *SubChunk {
+	for _, subChunk := range c.SubChunks {
+		if int(subChunk.Y) == y>>4 {
+			return subChunk
+		}
 	}
+	return nil
+}
 
-	subChunkY := y / 16
+// GetBlockAt returns the block runtime ID at the given position within the chunk
+// y is the absolute block Y coordinate. air is true for positions in all-air sub-chunks and, in
+// complete chunks, above the sub-chunks that were sent; these have no runtime ID. ok is false if
+// the position's sub-chunk has not been received.
+func (c *Chunk) GetBlockAt(x, y, z int) (runtimeID uint32, air bool, ok bool) {
+	if x < 0 || x >= 16 || z < 0 || z >= 16 {
+		return 0, false, false
+	}
 
-	// Ensure we have enough sub-chunks
-	for len(c.SubChunks) <= subChunkY {
-		c.SubChunks = append(c.SubChunks, &SubChunk{
-			Y:      int8(len(c.SubChunks)),
-			Blocks: make([]uint32, 4096), // 16x16x16
-		})
+	subChunk := c.subChunk(y)
+	if subChunk == nil {
+		return 0, c.Complete, c.Complete
 	}
+	if subChunk.Blocks == nil {
+		return 0, true, true
+	}
+
+	return subChunk.Blocks[((y&15)*256)+(z*16)+x], false, true
+}
 
-	subChunk := c.SubChunks[subChunkY]
-	localY := y % 16
-	index := (localY * 256) + (z * 16) + x
+// SetBlockAt sets the block runtime ID at the given position within the chunk
+// y is the absolute block Y coordinate. All-air sub-chunks (including those a complete chunk
+// omits) are filled with airID first. It fails if the position's sub-chunk has not been received.
+func (c *Chunk) SetBlockAt(x, y, z int, runtimeID, airID uint32) error {
+	if x < 0 || x >= 16 || z < 0 || z >= 16 {
+		return fmt.Errorf("position out of chunk bounds")
+	}
+	if y>>4 < -128 || y>>4 > 127 {
+		return fmt.Errorf("y position out of range")
+	}
 
-	if index < 0 || index >= len(subChunk.Blocks) {
-		return fmt.Errorf("block index out of range")
+	subChunk := c.subChunk(y)
+	if subChunk == nil {
+		if !c.Complete {
+			return ErrNotLoaded
+		}
+		subChunk = &SubChunk{Y: int8(y >> 4)}
+		c.SubChunks = append(c.SubChunks, subChunk)
+	}
+	if subChunk.Blocks == nil {
+		subChunk.Blocks = make([]uint32, blocksPerSubChunk)
+		for i := range subChunk.Blocks {
+			subChunk.Blocks[i] = airID
+		}
 	}
 
-	subChunk.Blocks[index] = runtimeID
+	subChunk.Blocks[((y&15)*256)+(z*16)+x] = runtimeID
 	return nil
 }
+
+// setSubChunk adds a sub-chunk to the chunk, replacing any sub-chunk at the same Y
+func (c *Chunk) setSubChunk(subChunk *SubChunk) {
+	for i, existing := range c.SubChunks {
+		if existing.Y == subChunk.Y {
+			c.SubChunks[i] = subChunk
+			return
+		}
+	}
+	c.SubChunks = append(c.SubChunks, subChunk)
+}
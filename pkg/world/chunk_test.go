@@ -0,0 +1,48 @@
+package world
+
+import "testing"
+
+func TestDecodeSubChunk(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		y       int8
+		wantY   int8
+		wantAir bool
+		wantID  uint32
+		wantErr bool
+	}{
+		{name: "uniform storage", data: []byte{subChunkVersionLayers, 1, 0x01, 0x0e}, y: 2, wantY: 2, wantID: 7},
+		{name: "indexed uniform storage", data: []byte{subChunkVersionIndexed, 1, 0xfd, 0x01, 0x0e}, y: 2, wantY: -3, wantID: 7},
+		{name: "no storages is air", data: []byte{subChunkVersionLayers, 0}, y: 1, wantY: 1, wantAir: true},
+		{name: "unknown version", data: []byte{42}, wantErr: true},
+		{name: "truncated", data: []byte{subChunkVersionLayers, 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subChunk, err := DecodeSubChunk(tt.data, tt.y)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeSubChunk: %v", err)
+			}
+			if subChunk.Y != tt.wantY {
+				t.Errorf("Y = %d, want %d", subChunk.Y, tt.wantY)
+			}
+			if tt.wantAir {
+				if subChunk.Blocks != nil {
+					t.Error("expected an all-air sub-chunk")
+				}
+				return
+			}
+			if len(subChunk.Blocks) != blocksPerSubChunk || subChunk.Blocks[123] != tt.wantID {
+				t.Errorf("blocks not filled with runtime ID %d", tt.wantID)
+			}
+		})
+	}
+}
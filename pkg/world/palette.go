@@ -0,0 +1,78 @@
+package world
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// NBT tag types used in block state compounds
+const (
+	tagEnd      = 0
+	tagByte     = 1
+	tagInt      = 3
+	tagString   = 8
+	tagCompound = 10
+)
+
+// NetworkBlockHash returns the hashed network ID of a block state, as used by servers that set
+// UseBlockNetworkIDHashes in StartGame
+// The hash is the 32-bit FNV-1a of the little-endian NBT compound {name, states}, with states in
+// key order. States may hold bool, uint8, int32 and string values.
+func NetworkBlockHash(name string, states map[string]any) (uint32, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(tagCompound)
+	writeNBTString(&buf, "")
+
+	buf.WriteByte(tagString)
+	writeNBTString(&buf, "name")
+	writeNBTString(&buf, name)
+
+	buf.WriteByte(tagCompound)
+	writeNBTString(&buf, "states")
+	keys := make([]string, 0, len(states))
+	for key := range states {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch v := states[key].(type) {
+		case bool:
+			buf.WriteByte(tagByte)
+			writeNBTString(&buf, key)
+			if v {
+				buf.WriteByte(1)
+			} else {
+				buf.WriteByte(0)
+			}
+		case uint8:
+			buf.WriteByte(tagByte)
+			writeNBTString(&buf, key)
+			buf.WriteByte(v)
+		case int32:
+			buf.WriteByte(tagInt)
+			writeNBTString(&buf, key)
+			_ = binary.Write(&buf, binary.LittleEndian, v)
+		case string:
+			buf.WriteByte(tagString)
+			writeNBTString(&buf, key)
+			writeNBTString(&buf, v)
+		default:
+			return 0, fmt.Errorf("block state %q of %s has unsupported type %T", key, name, v)
+		}
+	}
+	buf.WriteByte(tagEnd)
+	buf.WriteByte(tagEnd)
+
+	h := fnv.New32a()
+	h.Write(buf.Bytes())
+	return h.Sum32(), nil
+}
+
+// writeNBTString writes a little-endian NBT string (int16 length and bytes)
+func writeNBTString(buf *bytes.Buffer, s string) {
+	_ = binary.Write(buf, binary.LittleEndian, int16(len(s)))
+	buf.WriteString(s)
+}
@@ -0,0 +1,43 @@
+package world
+
+import "testing"
+
+func TestNetworkBlockHash(t *testing.T) {
+	tests := []struct {
+		name   string
+		block  string
+		states map[string]any
+		want   int32
+	}{
+		{name: "air", block: "minecraft:air", want: -604749536},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NetworkBlockHash(tt.block, tt.states)
+			if err != nil {
+				t.Fatalf("NetworkBlockHash(%q) error: %v", tt.block, err)
+			}
+			if int32(got) != tt.want {
+				t.Errorf("NetworkBlockHash(%q) = %d, want %d", tt.block, int32(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkBlockHashStateOrder(t *testing.T) {
+	a, err := NetworkBlockHash("minecraft:test", map[string]any{"a": int32(1), "b": "x", "c": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		b, _ := NetworkBlockHash("minecraft:test", map[string]any{"c": true, "b": "x", "a": int32(1)})
+		if a != b {
+			t.Fatalf("hash depends on map order: %d != %d", a, b)
+		}
+	}
+
+	if _, err := NetworkBlockHash("minecraft:test", map[string]any{"a": 1.5}); err == nil {
+		t.Error("expected an error for an unsupported state type")
+	}
+}
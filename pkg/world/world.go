@@ -1,11 +1,16 @@
 package world
 
 import (
+	"errors"
+	"math"
 	"sync"
 
 	"github.com/gollilla/best/pkg/types"
 )
 
+// ErrNotLoaded is returned when setting a block whose chunk or sub-chunk has not been received
+var ErrNotLoaded = errors.New("block position is not loaded")
+
 // World manages the world state including blocks and chunks
 type World struct {
 	blocks   map[types.Position]*types.Block
@@ -24,12 +29,15 @@ type ChunkPos struct {
 type Chunk struct {
 	Position ChunkPos
 	SubChunks []*SubChunk
+	// Complete is set when every sub-chunk is known, so sub-chunks not in SubChunks are air.
+	// Chunks loaded through sub-chunk requests only know the sub-chunks received so far.
+	Complete bool
 }
 
 // SubChunk represents a 16x16x16 section of a chunk
 type SubChunk struct {
 	Y      int8
-	Blocks []uint32 // Block runtime IDs
+	Blocks []uint32 // Block runtime IDs; nil for an all-air sub-chunk
 }
 
 // NewWorld creates a new world instance
@@ -78,6 +86,82 @@ func (w *World) GetChunk(chunkPos ChunkPos) (*Chunk, bool) {
 	return chunk, ok
 }
 
+// SetSubChunks adds the sub-chunks of chunk to the already loaded chunk at the same position
+// It is used for sub-chunks received in response to sub-chunk requests; sub-chunks for a chunk
+// that is not loaded are ignored.
+func (w *World) SetSubChunks(chunk *Chunk) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	loaded, ok := w.chunks[chunk.Position]
+	if !ok {
+		return
+	}
+	for _, subChunk := range chunk.SubChunks {
+		loaded.setSubChunk(subChunk)
+	}
+}
+
+// SetBlockAt sets the block runtime ID at the given position in its chunk
+// Updates for positions whose chunk or sub-chunk has not been received are ignored and return
+// ErrNotLoaded, so GetBlockAt keeps reporting them as unknown.
+func (w *World) SetBlockAt(pos types.Position, runtimeID uint32) error {
+	x, y, z := blockCoords(pos)
+	airID := w.airID()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	chunk, ok := w.chunks[ChunkPos{X: int32(x >> 4), Z: int32(z >> 4)}]
+	if !ok {
+		return ErrNotLoaded
+	}
+	return chunk.SetBlockAt(x&15, y, z&15, runtimeID, airID)
+}
+
+// GetBlockAt returns the block at the given position from the loaded chunks
+// It returns false for positions whose chunk or sub-chunk has not been received. Positions in
+// all-air sub-chunks are reported as minecraft:air. The block name is only set when the runtime
+// ID is in the registry.
+func (w *World) GetBlockAt(pos types.Position) (*types.Block, bool) {
+	x, y, z := blockCoords(pos)
+	blockPos := types.Position{X: float64(x), Y: float64(y), Z: float64(z)}
+
+	w.mu.RLock()
+	chunk, ok := w.chunks[ChunkPos{X: int32(x >> 4), Z: int32(z >> 4)}]
+	var runtimeID uint32
+	var air bool
+	if ok {
+		runtimeID, air, ok = chunk.GetBlockAt(x&15, y, z&15)
+	}
+	w.mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	airID := w.airID()
+	if air || runtimeID == airID {
+		return &types.Block{Name: "minecraft:air", Position: blockPos, RuntimeID: int32(airID)}, true
+	}
+
+	name, _ := w.registry.GetName(runtimeID)
+	return &types.Block{Name: name, Position: blockPos, RuntimeID: int32(runtimeID)}, true
+}
+
+// airID returns the runtime ID of minecraft:air, falling back to its hashed network ID
+func (w *World) airID() uint32 {
+	if id, ok := w.registry.GetID("minecraft:air"); ok {
+		return id
+	}
+	id, _ := NetworkBlockHash("minecraft:air", nil)
+	return id
+}
+
+// blockCoords returns the integer block coordinates containing pos
+func blockCoords(pos types.Position) (x, y, z int) {
+	return int(math.Floor(pos.X)), int(math.Floor(pos.Y)), int(math.Floor(pos.Z))
+}
+
 // Registry returns the block registry
 func (w *World) Registry() *BlockRegistry {
 	return w.registry
@@ -0,0 +1,77 @@
+package world
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gollilla/best/pkg/types"
+)
+
+func TestSetBlockAtUnloadedChunk(t *testing.T) {
+	w := NewWorld()
+	pos := types.Position{X: 1, Y: 64, Z: 1}
+
+	if err := w.SetBlockAt(pos, 42); !errors.Is(err, ErrNotLoaded) {
+		t.Fatalf("SetBlockAt on an unloaded chunk: got %v, want ErrNotLoaded", err)
+	}
+	if _, ok := w.GetBlockAt(types.Position{X: 2, Y: 64, Z: 2}); ok {
+		t.Error("GetBlockAt reports a block in a chunk that was never loaded")
+	}
+	if w.ChunkCount() != 0 {
+		t.Errorf("ChunkCount = %d, want 0", w.ChunkCount())
+	}
+}
+
+func TestGetBlockAt(t *testing.T) {
+	w := NewWorld()
+	w.Registry().Register(7, "minecraft:stone")
+
+	complete := &Chunk{Position: ChunkPos{X: 0, Z: 0}, Complete: true}
+	partial := &Chunk{Position: ChunkPos{X: 1, Z: 0}}
+	w.SetChunk(complete.Position, complete)
+	w.SetChunk(partial.Position, partial)
+
+	if err := w.SetBlockAt(types.Position{X: 3, Y: 64, Z: 3}, 7); err != nil {
+		t.Fatalf("SetBlockAt in a complete chunk: %v", err)
+	}
+	if err := w.SetBlockAt(types.Position{X: 19, Y: 64, Z: 3}, 7); !errors.Is(err, ErrNotLoaded) {
+		t.Fatalf("SetBlockAt in a missing sub-chunk: got %v, want ErrNotLoaded", err)
+	}
+
+	w.SetSubChunks(&Chunk{Position: partial.Position, SubChunks: []*SubChunk{{Y: 5}}})
+
+	tests := []struct {
+		name   string
+		pos    types.Position
+		want   string
+		wantOK bool
+	}{
+		{name: "set block", pos: types.Position{X: 3.5, Y: 64, Z: 3.5}, want: "minecraft:stone", wantOK: true},
+		{name: "air next to set block", pos: types.Position{X: 4, Y: 64, Z: 3}, want: "minecraft:air", wantOK: true},
+		{name: "omitted sub-chunk of complete chunk", pos: types.Position{X: 3, Y: 200, Z: 3}, want: "minecraft:air", wantOK: true},
+		{name: "received all-air sub-chunk", pos: types.Position{X: 20, Y: 85, Z: 3}, want: "minecraft:air", wantOK: true},
+		{name: "sub-chunk not received yet", pos: types.Position{X: 20, Y: 64, Z: 3}, wantOK: false},
+		{name: "unloaded chunk", pos: types.Position{X: -1, Y: 64, Z: 3}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, ok := w.GetBlockAt(tt.pos)
+			if ok != tt.wantOK {
+				t.Fatalf("GetBlockAt(%v) ok = %v, want %v", tt.pos, ok, tt.wantOK)
+			}
+			if ok && block.Name != tt.want {
+				t.Errorf("GetBlockAt(%v) = %q, want %q", tt.pos, block.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetSubChunksIgnoresUnloadedChunk(t *testing.T) {
+	w := NewWorld()
+	w.SetSubChunks(&Chunk{Position: ChunkPos{X: 3, Z: 3}, SubChunks: []*SubChunk{{Y: 4}}})
+
+	if w.ChunkCount() != 0 {
+		t.Errorf("ChunkCount = %d, want 0", w.ChunkCount())
+	}
+}
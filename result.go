@@ -0,0 +1,361 @@
+package best
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gollilla/best/pkg/runner"
+	"github.com/gollilla/best/pkg/scenario"
+)
+
+// Unified report formats supported by UnifiedResult.WriteReport
+const (
+	UnifiedFormatText  = "txt"
+	UnifiedFormatJSON  = "json"
+	UnifiedFormatJUnit = "junit"
+)
+
+// Sources of a UnifiedCase
+const (
+	UnifiedSourceTest     = "test"
+	UnifiedSourceScenario = "scenario"
+)
+
+// UnifiedStatusCancelled is the status of a scenario aborted before it finished
+// The other statuses are those of runner.TestStatus.
+const UnifiedStatusCancelled = "cancelled"
+
+// UnifiedCase is a single Go test case or scenario in a UnifiedResult
+type UnifiedCase struct {
+	Source   string // "test" or "scenario"
+	Suite    string
+	Name     string
+	Status   string // "passed", "failed", "skipped" or "cancelled"
+	Duration time.Duration
+	Message  string // Failure message or skip reason
+}
+
+// UnifiedResult combines runner test results and scenario summaries into one report
+// so that projects mixing Go tests and scenario files get a single pass/fail gate
+type UnifiedResult struct {
+	Cases    []UnifiedCase
+	Duration time.Duration
+}
+
+// NewUnifiedResult creates an empty unified result
+func NewUnifiedResult() *UnifiedResult {
+	return &UnifiedResult{
+		Cases: make([]UnifiedCase, 0),
+	}
+}
+
+// AddTestResult adds the test cases of a runner result
+func (u *UnifiedResult) AddTestResult(result *runner.TestResult) *UnifiedResult {
+	if result == nil {
+		return u
+	}
+
+	result.WalkSuites(func(suiteName string, suite *runner.SuiteResult) {
+		for _, test := range suite.Tests {
+			c := UnifiedCase{
+				Source:   UnifiedSourceTest,
+				Suite:    suiteName,
+				Name:     test.Name,
+				Status:   string(test.Status),
+				Duration: test.Duration,
+			}
+			if test.Error != nil {
+				c.Message = test.Error.Message
+			} else if test.Status == runner.TestStatusSkipped {
+				c.Message = test.SkipReason
+			}
+			u.Cases = append(u.Cases, c)
+		}
+	})
+	u.Duration += result.Duration
+	return u
+}
+
+// AddScenarioSummary adds each scenario of a scenario summary as one case
+func (u *UnifiedResult) AddScenarioSummary(summary *scenario.Summary) *UnifiedResult {
+	if summary == nil {
+		return u
+	}
+
+	for _, r := range summary.Results {
+		c := UnifiedCase{
+			Source:   UnifiedSourceScenario,
+			Suite:    UnifiedSourceScenario,
+			Name:     r.Scenario,
+			Status:   string(runner.TestStatusPassed),
+			Duration: r.Duration,
+		}
+		if r.Cancelled {
+			// An aborted run is not a failure of the scenario, but it did not pass either
+			c.Status = UnifiedStatusCancelled
+			c.Message = "cancelled"
+		} else if !r.Success {
+			c.Status = string(runner.TestStatusFailed)
+			c.Message = scenarioFailureMessage(r)
+		}
+		u.Cases = append(u.Cases, c)
+	}
+	u.Duration += summary.TotalDuration
+	return u
+}
+
+// scenarioFailureMessage describes the first failed step of a scenario
+func scenarioFailureMessage(r *scenario.Result) string {
+	for _, step := range r.Steps {
+		if step.Status == scenario.StepStatusFailed {
+			msg := fmt.Sprintf("step %d (%s): %s", step.StepNumber, step.Action, step.Description)
+			if step.Error != nil {
+				msg += ": " + step.Error.Error()
+			}
+			return msg
+		}
+	}
+	if r.Error != nil {
+		return r.Error.Error()
+	}
+	return "scenario failed"
+}
+
+// count returns the number of cases with the given status
+func (u *UnifiedResult) count(status string) int {
+	n := 0
+	for _, c := range u.Cases {
+		if c.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Passed returns the number of passed cases
+func (u *UnifiedResult) Passed() int { return u.count(string(runner.TestStatusPassed)) }
+
+// Failed returns the number of failed cases
+func (u *UnifiedResult) Failed() int { return u.count(string(runner.TestStatusFailed)) }
+
+// Skipped returns the number of skipped cases
+func (u *UnifiedResult) Skipped() int { return u.count(string(runner.TestStatusSkipped)) }
+
+// Cancelled returns the number of cancelled scenarios
+func (u *UnifiedResult) Cancelled() int { return u.count(UnifiedStatusCancelled) }
+
+// Success returns true if no case failed or was cancelled
+func (u *UnifiedResult) Success() bool {
+	return u.Failed() == 0 && u.Cancelled() == 0
+}
+
+// WriteConsole writes a combined human-readable report
+func (u *UnifiedResult) WriteConsole(w io.Writer) {
+	separator := strings.Repeat("=", 50)
+	fmt.Fprintf(w, "\n%s\n", separator)
+	fmt.Fprintln(w, "Combined Results:")
+	fmt.Fprintln(w, separator)
+
+	for _, c := range u.Cases {
+		icon := "✓"
+		switch c.Status {
+		case string(runner.TestStatusFailed):
+			icon = "✗"
+		case string(runner.TestStatusSkipped):
+			icon = "○"
+		case UnifiedStatusCancelled:
+			icon = "⊘"
+		}
+		name := c.Name
+		if c.Suite != "" {
+			name = c.Suite + " > " + c.Name
+		}
+		fmt.Fprintf(w, "  %s [%s] %s (%dms)\n", icon, c.Source, name, c.Duration.Milliseconds())
+		if (c.Status == string(runner.TestStatusFailed) || c.Status == UnifiedStatusCancelled) && c.Message != "" {
+			fmt.Fprintf(w, "      → %s\n", c.Message)
+		}
+	}
+
+	fmt.Fprintln(w, separator)
+	fmt.Fprintf(w, "  Passed:  %d\n", u.Passed())
+	fmt.Fprintf(w, "  Failed:  %d\n", u.Failed())
+	fmt.Fprintf(w, "  Skipped: %d\n", u.Skipped())
+	if cancelled := u.Cancelled(); cancelled > 0 {
+		fmt.Fprintf(w, "  Cancelled: %d\n", cancelled)
+	}
+	fmt.Fprintf(w, "  Duration: %dms\n", u.Duration.Milliseconds())
+	fmt.Fprintln(w, separator)
+}
+
+// unifiedJSON is the JSON representation of a UnifiedResult
+type unifiedJSON struct {
+	Success   bool              `json:"success"`
+	Passed    int               `json:"passed"`
+	Failed    int               `json:"failed"`
+	Skipped   int               `json:"skipped"`
+	Cancelled int               `json:"cancelled"`
+	Duration  string            `json:"duration"`
+	Cases     []unifiedCaseJSON `json:"cases"`
+}
+
+type unifiedCaseJSON struct {
+	Source   string `json:"source"`
+	Suite    string `json:"suite,omitempty"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Message  string `json:"message,omitempty"`
+}
+
+// JSON renders the result as indented JSON
+func (u *UnifiedResult) JSON() ([]byte, error) {
+	report := unifiedJSON{
+		Success:   u.Success(),
+		Passed:    u.Passed(),
+		Failed:    u.Failed(),
+		Skipped:   u.Skipped(),
+		Cancelled: u.Cancelled(),
+		Duration:  u.Duration.Round(time.Millisecond).String(),
+		Cases:     make([]unifiedCaseJSON, 0, len(u.Cases)),
+	}
+	for _, c := range u.Cases {
+		report.Cases = append(report.Cases, unifiedCaseJSON{
+			Source:   c.Source,
+			Suite:    c.Suite,
+			Name:     c.Name,
+			Status:   c.Status,
+			Duration: c.Duration.Round(time.Millisecond).String(),
+			Message:  c.Message,
+		})
+	}
+	return json.MarshalIndent(report, "", "  ")
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnit renders the result as JUnit XML, one testsuite per source and suite
+// Cancelled scenarios are reported as errors so that CI does not treat an aborted run as passed.
+func (u *UnifiedResult) JUnit() ([]byte, error) {
+	report := junitTestSuites{
+		Tests:    len(u.Cases),
+		Failures: u.Failed(),
+		Errors:   u.Cancelled(),
+		Skipped:  u.Skipped(),
+		Time:     fmt.Sprintf("%.3f", u.Duration.Seconds()),
+	}
+
+	index := make(map[string]int)
+	for _, c := range u.Cases {
+		key := c.Source + "/" + c.Suite
+		i, ok := index[key]
+		if !ok {
+			name := c.Suite
+			if c.Source == UnifiedSourceTest && name == "" {
+				name = UnifiedSourceTest
+			}
+			report.Suites = append(report.Suites, junitTestSuite{Name: name})
+			i = len(report.Suites) - 1
+			index[key] = i
+		}
+
+		suite := &report.Suites[i]
+		tc := junitTestCase{
+			Name:      c.Name,
+			ClassName: c.Source + "." + suite.Name,
+			Time:      fmt.Sprintf("%.3f", c.Duration.Seconds()),
+		}
+		switch c.Status {
+		case string(runner.TestStatusFailed):
+			tc.Failure = &junitMessage{Message: firstLine(c.Message), Text: c.Message}
+			suite.Failures++
+		case string(runner.TestStatusSkipped):
+			tc.Skipped = &junitMessage{Message: c.Message}
+			suite.Skipped++
+		case UnifiedStatusCancelled:
+			tc.Error = &junitMessage{Message: firstLine(c.Message), Text: c.Message}
+			suite.Errors++
+		}
+		suite.Tests++
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// firstLine returns the first line of s
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// WriteReport writes the combined report to a file in the given format ("txt", "json" or "junit")
+func (u *UnifiedResult) WriteReport(path string, format string) error {
+	var data []byte
+	var err error
+
+	switch strings.ToLower(format) {
+	case UnifiedFormatText, "text":
+		var buf bytes.Buffer
+		u.WriteConsole(&buf)
+		data = buf.Bytes()
+	case UnifiedFormatJSON:
+		data, err = u.JSON()
+	case UnifiedFormatJUnit, "xml":
+		data, err = u.JUnit()
+	default:
+		return fmt.Errorf("unsupported report format: %s (supported: txt, json, junit)", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to format report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package best
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gollilla/best/pkg/scenario"
+)
+
+func TestUnifiedResultCancelled(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*scenario.Result
+		want    bool
+	}{
+		{"all passed", []*scenario.Result{{Scenario: "a", Success: true}}, true},
+		{"failed", []*scenario.Result{{Scenario: "a", Success: true}, {Scenario: "b"}}, false},
+		{"cancelled", []*scenario.Result{{Scenario: "a", Success: true}, {Scenario: "b", Cancelled: true}}, false},
+	}
+
+	for _, tt := range tests {
+		u := NewUnifiedResult().AddScenarioSummary(&scenario.Summary{Results: tt.results})
+		if got := u.Success(); got != tt.want {
+			t.Errorf("%s: Success() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	u := NewUnifiedResult().AddScenarioSummary(&scenario.Summary{Results: []*scenario.Result{
+		{Scenario: "a", Cancelled: true},
+	}})
+	if u.Cancelled() != 1 || u.Skipped() != 0 || u.Failed() != 0 {
+		t.Errorf("counts = cancelled %d, skipped %d, failed %d, want 1, 0, 0", u.Cancelled(), u.Skipped(), u.Failed())
+	}
+	out, err := u.JUnit()
+	if err != nil {
+		t.Fatalf("JUnit() error = %v", err)
+	}
+	if !strings.Contains(string(out), `errors="1"`) || !strings.Contains(string(out), "<error") {
+		t.Errorf("JUnit() does not report the cancelled scenario as an error:\n%s", out)
+	}
+}